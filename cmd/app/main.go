@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/database"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/job"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/server"
 )
 
@@ -11,6 +12,8 @@ func main() {
 	psqlDB := database.NewPgsqlConn()
 	defer psqlDB.Close()
 
+	job.StartTrashPurge(psqlDB)
+
 	server.MountMiddlewares()
 	server.MountRoutes(psqlDB)
 	server.Start(env.AppEnv.AppPort)
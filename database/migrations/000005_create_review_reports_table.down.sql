@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_review_reports_timestamp ON review_reports;
+
+DROP INDEX IF EXISTS review_reports_review_index;
+DROP INDEX IF EXISTS review_reports_open_reporter_index;
+
+DROP TABLE IF EXISTS review_reports;
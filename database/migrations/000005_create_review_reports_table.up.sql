@@ -0,0 +1,20 @@
+CREATE TABLE review_reports (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL,
+  user_id VARCHAR(255) NOT NULL,
+  reporter_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  reason VARCHAR(255) NOT NULL,
+  resolved_at TIMESTAMP NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (session_id, user_id) REFERENCES session_attendees(session_id, user_id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_review_reports_timestamp
+BEFORE UPDATE ON review_reports
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE INDEX review_reports_review_index ON review_reports(session_id, user_id);
+CREATE UNIQUE INDEX review_reports_open_reporter_index ON review_reports(session_id, user_id, reporter_id)
+WHERE resolved_at IS NULL;
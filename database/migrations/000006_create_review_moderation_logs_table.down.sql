@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS review_moderation_logs_review_index;
+
+DROP TABLE IF EXISTS review_moderation_logs;
@@ -0,0 +1,12 @@
+CREATE TABLE review_moderation_logs (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL,
+  user_id VARCHAR(255) NOT NULL,
+  moderator_id VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL,
+  action SMALLINT NOT NULL, -- 1: hide, 2: restore, 3: dismiss
+  reason VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (session_id, user_id) REFERENCES session_attendees(session_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX review_moderation_logs_review_index ON review_moderation_logs(session_id, user_id);
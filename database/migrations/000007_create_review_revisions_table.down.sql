@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS review_revisions_review_index;
+
+DROP TABLE IF EXISTS review_revisions;
+
+ALTER TABLE session_attendees DROP COLUMN IF EXISTS review_edited_at;
@@ -0,0 +1,12 @@
+ALTER TABLE session_attendees ADD COLUMN review_edited_at TIMESTAMP NULL;
+
+CREATE TABLE review_revisions (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL,
+  user_id VARCHAR(255) NOT NULL,
+  review VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (session_id, user_id) REFERENCES session_attendees(session_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX review_revisions_review_index ON review_revisions(session_id, user_id);
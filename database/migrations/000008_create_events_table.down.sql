@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS sessions_event_id_index;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS event_id;
+
+DROP TRIGGER IF EXISTS update_events_timestamp ON events;
+
+DROP INDEX IF EXISTS events_start_at_index;
+
+DROP TABLE IF EXISTS events;
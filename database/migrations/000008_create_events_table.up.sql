@@ -0,0 +1,20 @@
+CREATE TABLE events (
+  id VARCHAR(255) PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  description TEXT,
+  start_at TIMESTAMP NOT NULL,
+  end_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_events_timestamp
+BEFORE UPDATE ON events
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE INDEX events_start_at_index ON events(start_at);
+
+ALTER TABLE sessions ADD COLUMN event_id VARCHAR(255) NULL REFERENCES events(id) ON DELETE SET NULL;
+
+CREATE INDEX sessions_event_id_index ON sessions(event_id);
@@ -0,0 +1,23 @@
+DROP INDEX IF EXISTS survey_answers_response_id_index;
+DROP INDEX IF EXISTS survey_answers_question_id_index;
+
+DROP TABLE IF EXISTS survey_answers;
+
+DROP INDEX IF EXISTS survey_responses_session_id_index;
+
+DROP TABLE IF EXISTS survey_responses;
+
+DROP INDEX IF EXISTS survey_question_options_question_id_index;
+
+DROP TABLE IF EXISTS survey_question_options;
+
+DROP INDEX IF EXISTS survey_questions_template_id_index;
+
+DROP TABLE IF EXISTS survey_questions;
+
+DROP TRIGGER IF EXISTS update_survey_templates_timestamp ON survey_templates;
+
+DROP INDEX IF EXISTS survey_templates_event_id_index;
+DROP INDEX IF EXISTS survey_templates_session_type_index;
+
+DROP TABLE IF EXISTS survey_templates;
@@ -0,0 +1,66 @@
+CREATE TABLE survey_templates (
+  id VARCHAR(255) PRIMARY KEY,
+  title VARCHAR(255) NOT NULL,
+  description TEXT,
+  event_id VARCHAR(255) NULL REFERENCES events(id) ON DELETE CASCADE,
+  session_type SMALLINT NULL,
+  created_by VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+  CONSTRAINT event_or_session_type CHECK (event_id IS NOT NULL OR session_type IS NOT NULL)
+);
+
+CREATE TRIGGER update_survey_templates_timestamp
+BEFORE UPDATE ON survey_templates
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE INDEX survey_templates_event_id_index ON survey_templates(event_id);
+CREATE INDEX survey_templates_session_type_index ON survey_templates(session_type);
+
+CREATE TABLE survey_questions (
+  id VARCHAR(255) PRIMARY KEY,
+  template_id VARCHAR(255) NOT NULL REFERENCES survey_templates(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  type SMALLINT NOT NULL, -- 1: single choice, 2: multiple choice, 3: scale, 4: free text
+  prompt VARCHAR(255) NOT NULL,
+  required BOOLEAN NOT NULL DEFAULT FALSE,
+  scale_min INT NULL,
+  scale_max INT NULL
+);
+
+CREATE INDEX survey_questions_template_id_index ON survey_questions(template_id);
+
+CREATE TABLE survey_question_options (
+  id VARCHAR(255) PRIMARY KEY,
+  question_id VARCHAR(255) NOT NULL REFERENCES survey_questions(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  label VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX survey_question_options_question_id_index ON survey_question_options(question_id);
+
+CREATE TABLE survey_responses (
+  id VARCHAR(255) PRIMARY KEY,
+  template_id VARCHAR(255) NOT NULL REFERENCES survey_templates(id) ON DELETE CASCADE,
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+  UNIQUE (template_id, session_id, user_id)
+);
+
+CREATE INDEX survey_responses_session_id_index ON survey_responses(session_id);
+
+CREATE TABLE survey_answers (
+  id VARCHAR(255) PRIMARY KEY,
+  response_id VARCHAR(255) NOT NULL REFERENCES survey_responses(id) ON DELETE CASCADE,
+  question_id VARCHAR(255) NOT NULL REFERENCES survey_questions(id) ON DELETE CASCADE,
+  option_id VARCHAR(255) NULL REFERENCES survey_question_options(id) ON DELETE CASCADE,
+  scale_value INT NULL,
+  text_value TEXT NULL
+);
+
+CREATE INDEX survey_answers_response_id_index ON survey_answers(response_id);
+CREATE INDEX survey_answers_question_id_index ON survey_answers(question_id);
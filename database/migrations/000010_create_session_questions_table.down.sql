@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS session_question_votes;
+
+DROP TRIGGER IF EXISTS update_session_questions_timestamp ON session_questions;
+
+DROP INDEX IF EXISTS session_questions_session_id_index;
+
+DROP TABLE IF EXISTS session_questions;
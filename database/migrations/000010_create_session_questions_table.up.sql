@@ -0,0 +1,26 @@
+CREATE TABLE session_questions (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL,
+  user_id VARCHAR(255) NOT NULL,
+  content VARCHAR(500) NOT NULL,
+  status SMALLINT NOT NULL DEFAULT 1, -- 1: open, 2: answered, 3: hidden
+  answered_at TIMESTAMP NULL,
+  hidden_at TIMESTAMP NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (session_id, user_id) REFERENCES session_attendees(session_id, user_id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_session_questions_timestamp
+BEFORE UPDATE ON session_questions
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE INDEX session_questions_session_id_index ON session_questions(session_id);
+
+CREATE TABLE session_question_votes (
+  question_id VARCHAR(255) NOT NULL REFERENCES session_questions(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (question_id, user_id)
+);
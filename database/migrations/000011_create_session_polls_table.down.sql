@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS session_poll_votes_option_id_index;
+
+DROP TABLE IF EXISTS session_poll_votes;
+
+DROP INDEX IF EXISTS session_poll_options_poll_id_index;
+
+DROP TABLE IF EXISTS session_poll_options;
+
+DROP TRIGGER IF EXISTS update_session_polls_timestamp ON session_polls;
+
+DROP INDEX IF EXISTS session_polls_session_id_index;
+
+DROP TABLE IF EXISTS session_polls;
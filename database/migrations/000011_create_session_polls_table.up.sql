@@ -0,0 +1,36 @@
+CREATE TABLE session_polls (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  question VARCHAR(255) NOT NULL,
+  status SMALLINT NOT NULL DEFAULT 1, -- 1: draft, 2: open, 3: closed
+  opened_at TIMESTAMP NULL,
+  closed_at TIMESTAMP NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_session_polls_timestamp
+BEFORE UPDATE ON session_polls
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE INDEX session_polls_session_id_index ON session_polls(session_id);
+
+CREATE TABLE session_poll_options (
+  id VARCHAR(255) PRIMARY KEY,
+  poll_id VARCHAR(255) NOT NULL REFERENCES session_polls(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  label VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX session_poll_options_poll_id_index ON session_poll_options(poll_id);
+
+CREATE TABLE session_poll_votes (
+  poll_id VARCHAR(255) NOT NULL REFERENCES session_polls(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  option_id VARCHAR(255) NOT NULL REFERENCES session_poll_options(id) ON DELETE CASCADE,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (poll_id, user_id)
+);
+
+CREATE INDEX session_poll_votes_option_id_index ON session_poll_votes(option_id);
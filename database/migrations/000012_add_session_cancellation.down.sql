@@ -0,0 +1,8 @@
+UPDATE sessions
+SET status = 2, deleted_at = cancelled_at, deleted_reason = cancelled_reason
+WHERE status = 4;
+
+ALTER TABLE session_attendees DROP COLUMN IF EXISTS released_at;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS cancelled_reason;
+ALTER TABLE sessions DROP COLUMN IF EXISTS cancelled_at;
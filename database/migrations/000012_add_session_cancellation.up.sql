@@ -0,0 +1,8 @@
+ALTER TABLE sessions ADD COLUMN cancelled_at TIMESTAMP NULL;
+ALTER TABLE sessions ADD COLUMN cancelled_reason VARCHAR(255) NULL;
+
+ALTER TABLE session_attendees ADD COLUMN released_at TIMESTAMP NULL;
+
+UPDATE sessions
+SET status = 4, cancelled_at = deleted_at, cancelled_reason = deleted_reason, deleted_at = NULL, deleted_reason = NULL
+WHERE deleted_at IS NOT NULL AND status = 2;
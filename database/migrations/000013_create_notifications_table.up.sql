@@ -0,0 +1,12 @@
+CREATE TABLE notifications (
+  id VARCHAR(255) PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  type SMALLINT NOT NULL, -- 1: session cancelled, 2: session restored, 3: registration released
+  title VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  session_id VARCHAR(255) NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  read_at TIMESTAMP NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX notifications_user_id_index ON notifications(user_id, created_at);
@@ -0,0 +1,9 @@
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS sessions_deleted_at_index;
+DROP INDEX IF EXISTS users_deleted_at_index;
+
+DROP INDEX IF EXISTS users_email_unique_index;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMP NULL;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+CREATE UNIQUE INDEX users_email_unique_index ON users(email) WHERE deleted_at IS NULL;
+
+CREATE INDEX users_deleted_at_index ON users(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX sessions_deleted_at_index ON sessions(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS refresh_users_session_search ON users;
+DROP TRIGGER IF EXISTS refresh_sessions_search ON sessions;
+
+DROP FUNCTION IF EXISTS refresh_proposer_session_search();
+DROP FUNCTION IF EXISTS refresh_session_search();
+DROP FUNCTION IF EXISTS session_search_document(VARCHAR);
+
+DROP INDEX IF EXISTS session_search_document_index;
+
+DROP TABLE IF EXISTS session_search;
@@ -0,0 +1,65 @@
+CREATE TABLE session_search (
+  session_id VARCHAR(255) PRIMARY KEY REFERENCES sessions(id) ON DELETE CASCADE,
+  document TSVECTOR NOT NULL
+);
+
+CREATE INDEX session_search_document_index ON session_search USING GIN(document);
+
+-- Title and description are indexed unstemmed ('simple', for prefix matching)
+-- and stemmed for both English and Indonesian. Tags and speaker names are
+-- indexed as is.
+CREATE FUNCTION session_search_document(VARCHAR)
+RETURNS TSVECTOR AS $$
+	SELECT
+		setweight(to_tsvector('simple', sessions.title), 'A') ||
+		setweight(to_tsvector('english', sessions.title), 'A') ||
+		setweight(to_tsvector('indonesian', sessions.title), 'A') ||
+		setweight(to_tsvector('simple', users.name), 'B') ||
+		setweight(to_tsvector('simple', concat_ws(' ',
+			CASE WHEN sessions.tags & 32 <> 0 THEN 'PM Product Management' END,
+			CASE WHEN sessions.tags & 16 <> 0 THEN 'PD Product Design' END,
+			CASE WHEN sessions.tags & 8 <> 0 THEN 'FE Frontend' END,
+			CASE WHEN sessions.tags & 4 <> 0 THEN 'BE Backend' END,
+			CASE WHEN sessions.tags & 2 <> 0 THEN 'DS Data Science' END,
+			CASE WHEN sessions.tags & 1 <> 0 THEN 'CP Competitive Programming' END
+		)), 'B') ||
+		setweight(to_tsvector('simple', coalesce(sessions.description, '')), 'C') ||
+		setweight(to_tsvector('english', coalesce(sessions.description, '')), 'C') ||
+		setweight(to_tsvector('indonesian', coalesce(sessions.description, '')), 'C')
+	FROM sessions JOIN users ON users.id = sessions.proposer_id
+	WHERE sessions.id = $1;
+$$ LANGUAGE SQL STABLE;
+
+CREATE FUNCTION refresh_session_search()
+RETURNS TRIGGER AS $$
+BEGIN
+	INSERT INTO session_search (session_id, document)
+	VALUES (NEW.id, session_search_document(NEW.id))
+	ON CONFLICT (session_id) DO UPDATE SET document = EXCLUDED.document;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION refresh_proposer_session_search()
+RETURNS TRIGGER AS $$
+BEGIN
+	UPDATE session_search
+	SET document = session_search_document(session_search.session_id)
+	FROM sessions
+	WHERE sessions.id = session_search.session_id AND sessions.proposer_id = NEW.id;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER refresh_sessions_search
+AFTER INSERT OR UPDATE OF title, description, tags, proposer_id ON sessions
+FOR EACH ROW
+EXECUTE FUNCTION refresh_session_search();
+
+CREATE TRIGGER refresh_users_session_search
+AFTER UPDATE OF name ON users
+FOR EACH ROW
+EXECUTE FUNCTION refresh_proposer_session_search();
+
+INSERT INTO session_search (session_id, document)
+SELECT id, session_search_document(id) FROM sessions;
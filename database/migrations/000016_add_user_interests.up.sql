@@ -0,0 +1,2 @@
+-- same bit layout as sessions.tags
+ALTER TABLE users ADD COLUMN interests SMALLINT NOT NULL DEFAULT 0;
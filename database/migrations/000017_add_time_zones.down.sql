@@ -0,0 +1,11 @@
+ALTER TABLE sessions
+  ALTER COLUMN start_at TYPE TIMESTAMP USING start_at AT TIME ZONE 'Asia/Jakarta',
+  ALTER COLUMN end_at TYPE TIMESTAMP USING end_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE events
+  ALTER COLUMN start_at TYPE TIMESTAMP USING start_at AT TIME ZONE 'Asia/Jakarta',
+  ALTER COLUMN end_at TYPE TIMESTAMP USING end_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE events DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE users DROP COLUMN IF EXISTS time_zone;
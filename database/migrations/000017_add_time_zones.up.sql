@@ -0,0 +1,15 @@
+ALTER TABLE users ADD COLUMN time_zone VARCHAR(64) NULL;
+
+ALTER TABLE events ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+ALTER TABLE sessions ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta';
+
+UPDATE sessions SET time_zone = events.time_zone FROM events WHERE events.id = sessions.event_id;
+
+-- the old columns held Jakarta wall times, so they are read in that zone
+ALTER TABLE events
+  ALTER COLUMN start_at TYPE TIMESTAMPTZ USING start_at AT TIME ZONE 'Asia/Jakarta',
+  ALTER COLUMN end_at TYPE TIMESTAMPTZ USING end_at AT TIME ZONE 'Asia/Jakarta';
+
+ALTER TABLE sessions
+  ALTER COLUMN start_at TYPE TIMESTAMPTZ USING start_at AT TIME ZONE 'Asia/Jakarta',
+  ALTER COLUMN end_at TYPE TIMESTAMPTZ USING end_at AT TIME ZONE 'Asia/Jakarta';
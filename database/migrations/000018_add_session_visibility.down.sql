@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS session_invites_user_id_index;
+DROP INDEX IF EXISTS session_invites_session_id_index;
+
+DROP TABLE IF EXISTS session_invites;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS visibility;
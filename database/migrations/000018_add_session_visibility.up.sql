@@ -0,0 +1,18 @@
+ALTER TABLE sessions ADD COLUMN visibility SMALLINT NOT NULL DEFAULT 1; -- 1: public, 2: unlisted, 3: invite only
+
+-- an invite is either a shareable code or an allowlist entry for a user or an email domain
+CREATE TABLE session_invites (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  code VARCHAR(32) NULL UNIQUE,
+  user_id VARCHAR(255) NULL REFERENCES users(id) ON DELETE CASCADE,
+  email_domain VARCHAR(255) NULL, -- lowercase, without the @
+  max_uses INT NULL,
+  uses INT NOT NULL DEFAULT 0,
+  expires_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  CHECK (num_nonnulls(code, user_id, email_domain) = 1)
+);
+
+CREATE INDEX session_invites_session_id_index ON session_invites(session_id);
+CREATE INDEX session_invites_user_id_index ON session_invites(user_id) WHERE user_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS registration_requests_queue_index;
+
+DROP TABLE IF EXISTS registration_requests;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS requires_approval;
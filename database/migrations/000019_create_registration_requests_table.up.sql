@@ -0,0 +1,15 @@
+ALTER TABLE sessions ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- registrations waiting for the speaker, an approval moves the user into session_attendees
+CREATE TABLE registration_requests (
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  status SMALLINT NOT NULL DEFAULT 1, -- 1: pending, 2: approved, 3: declined
+  reason VARCHAR(255) NULL,
+  decided_by VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL,
+  decided_at TIMESTAMPTZ NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (session_id, user_id)
+);
+
+CREATE INDEX registration_requests_queue_index ON registration_requests(session_id, status, created_at);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS registration_answers_session_id_user_id_index;
+DROP INDEX IF EXISTS registration_answers_question_id_index;
+
+DROP TABLE IF EXISTS registration_answers;
+
+DROP INDEX IF EXISTS registration_question_options_question_id_index;
+
+DROP TABLE IF EXISTS registration_question_options;
+
+DROP INDEX IF EXISTS registration_questions_session_id_index;
+
+DROP TABLE IF EXISTS registration_questions;
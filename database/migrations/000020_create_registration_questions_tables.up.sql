@@ -0,0 +1,34 @@
+-- the form people fill in when registering for a session
+CREATE TABLE registration_questions (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  type SMALLINT NOT NULL, -- 1: text, 2: select, 3: checkbox
+  prompt VARCHAR(255) NOT NULL,
+  required BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX registration_questions_session_id_index ON registration_questions(session_id);
+
+CREATE TABLE registration_question_options (
+  id VARCHAR(255) PRIMARY KEY,
+  question_id VARCHAR(255) NOT NULL REFERENCES registration_questions(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  label VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX registration_question_options_question_id_index ON registration_question_options(question_id);
+
+-- kept per session and user so they follow a pending request into session_attendees
+CREATE TABLE registration_answers (
+  id VARCHAR(255) PRIMARY KEY,
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  question_id VARCHAR(255) NOT NULL REFERENCES registration_questions(id) ON DELETE CASCADE,
+  option_id VARCHAR(255) NULL REFERENCES registration_question_options(id) ON DELETE CASCADE,
+  text_value TEXT NULL,
+  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX registration_answers_session_id_user_id_index ON registration_answers(session_id, user_id);
+CREATE INDEX registration_answers_question_id_index ON registration_answers(question_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS session_prerequisites_prerequisite_id_index;
+
+DROP TABLE IF EXISTS session_prerequisites;
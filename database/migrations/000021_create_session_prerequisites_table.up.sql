@@ -0,0 +1,10 @@
+-- sessions a user has to attend, or at least register for, before registering for session_id
+CREATE TABLE session_prerequisites (
+  session_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  prerequisite_id VARCHAR(255) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+  PRIMARY KEY (session_id, prerequisite_id),
+
+  CONSTRAINT not_own_prerequisite CHECK (session_id <> prerequisite_id)
+);
+
+CREATE INDEX session_prerequisites_prerequisite_id_index ON session_prerequisites(prerequisite_id);
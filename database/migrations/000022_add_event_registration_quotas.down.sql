@@ -0,0 +1,3 @@
+ALTER TABLE events DROP COLUMN IF EXISTS max_registrations_per_day;
+ALTER TABLE events DROP COLUMN IF EXISTS max_registrations_per_tag;
+ALTER TABLE events DROP COLUMN IF EXISTS max_registrations;
@@ -0,0 +1,4 @@
+-- limits on the registrations one user holds across the sessions of an event, NULL means no limit
+ALTER TABLE events ADD COLUMN max_registrations INT NULL;
+ALTER TABLE events ADD COLUMN max_registrations_per_tag INT NULL;
+ALTER TABLE events ADD COLUMN max_registrations_per_day INT NULL;
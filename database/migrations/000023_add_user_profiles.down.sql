@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS user_links;
+
+ALTER TABLE users DROP COLUMN IF EXISTS links_visibility;
+ALTER TABLE users DROP COLUMN IF EXISTS job_title_visibility;
+ALTER TABLE users DROP COLUMN IF EXISTS affiliation_visibility;
+ALTER TABLE users DROP COLUMN IF EXISTS bio_visibility;
+ALTER TABLE users DROP COLUMN IF EXISTS email_visibility;
+
+ALTER TABLE users DROP COLUMN IF EXISTS job_title;
+ALTER TABLE users DROP COLUMN IF EXISTS affiliation;
+ALTER TABLE users DROP COLUMN IF EXISTS bio;
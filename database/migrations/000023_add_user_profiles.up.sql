@@ -0,0 +1,22 @@
+-- public speaker profile
+ALTER TABLE users ADD COLUMN bio TEXT NULL;
+ALTER TABLE users ADD COLUMN affiliation VARCHAR(255) NULL;
+ALTER TABLE users ADD COLUMN job_title VARCHAR(255) NULL;
+
+-- who can see each profile field, 1: everyone, 2: coordinators only
+ALTER TABLE users ADD COLUMN email_visibility SMALLINT NOT NULL DEFAULT 2;
+ALTER TABLE users ADD COLUMN bio_visibility SMALLINT NOT NULL DEFAULT 1;
+ALTER TABLE users ADD COLUMN affiliation_visibility SMALLINT NOT NULL DEFAULT 1;
+ALTER TABLE users ADD COLUMN job_title_visibility SMALLINT NOT NULL DEFAULT 1;
+ALTER TABLE users ADD COLUMN links_visibility SMALLINT NOT NULL DEFAULT 1;
+
+-- social and portfolio links
+CREATE TABLE user_links (
+  id VARCHAR(255) PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  label VARCHAR(50) NOT NULL,
+  url VARCHAR(2048) NOT NULL
+);
+
+CREATE INDEX user_links_user_id_index ON user_links(user_id);
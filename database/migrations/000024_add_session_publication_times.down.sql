@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS track_sessions_publication ON sessions;
+DROP FUNCTION IF EXISTS track_session_publication;
+
+DROP INDEX IF EXISTS sessions_approved_at_index;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS rescheduled_at;
+ALTER TABLE sessions DROP COLUMN IF EXISTS approved_at;
@@ -0,0 +1,33 @@
+-- when a session was first approved and when an approved session was last moved, for the session feeds
+ALTER TABLE sessions ADD COLUMN approved_at TIMESTAMPTZ NULL;
+ALTER TABLE sessions ADD COLUMN rescheduled_at TIMESTAMPTZ NULL;
+
+-- sessions approved before the column existed are dated by their last update
+UPDATE sessions SET approved_at = updated_at WHERE status IN (2, 4);
+
+CREATE INDEX sessions_approved_at_index ON sessions(approved_at);
+
+-- kept by the database so every path that approves or moves a session is covered,
+-- moves of less than 30 minutes are minor and not announced
+CREATE FUNCTION track_session_publication()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF NEW.status = 2 AND NEW.approved_at IS NULL THEN
+		NEW.approved_at = CURRENT_TIMESTAMP;
+	END IF;
+
+	IF TG_OP = 'UPDATE' AND OLD.status = 2 AND NEW.status = 2 AND (
+		abs(extract(epoch FROM NEW.start_at - OLD.start_at)) >= 1800
+		OR abs(extract(epoch FROM NEW.end_at - OLD.end_at)) >= 1800
+	) THEN
+		NEW.rescheduled_at = CURRENT_TIMESTAMP;
+	END IF;
+
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_sessions_publication
+BEFORE INSERT OR UPDATE ON sessions
+FOR EACH ROW
+EXECUTE FUNCTION track_session_publication();
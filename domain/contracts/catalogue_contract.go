@@ -0,0 +1,39 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type CatalogueRepository interface {
+	FindSessions(
+		ctx context.Context,
+		limit, offset int,
+		eventID, speakerID uuid.UUID,
+		tags int16,
+		beforeAt, afterAt time.Time,
+	) ([]entity.Session, error)
+	CountSessions(
+		ctx context.Context,
+		eventID, speakerID uuid.UUID,
+		tags int16,
+		beforeAt, afterAt time.Time,
+	) (int64, error)
+	FindSessionByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	FindSpeakers(ctx context.Context, limit, offset int, eventID uuid.UUID) ([]entity.User, error)
+	CountSpeakers(ctx context.Context, eventID uuid.UUID) (int64, error)
+	FindSpeakerByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	FindRooms(ctx context.Context, eventID uuid.UUID) ([]entity.Room, error)
+}
+
+type CatalogueService interface {
+	GetSessions(ctx context.Context, query dto.GetPublicSessionsQuery) (dto.GetPublicSessionsResponse, error)
+	GetSession(ctx context.Context, query dto.GetPublicSessionQuery) (dto.GetPublicSessionResponse, error)
+	GetSpeakers(ctx context.Context, query dto.GetPublicSpeakersQuery) (dto.GetPublicSpeakersResponse, error)
+	GetSpeaker(ctx context.Context, query dto.GetPublicSpeakerQuery) (dto.GetPublicSpeakerResponse, error)
+	GetRooms(ctx context.Context, query dto.GetPublicRoomsQuery) (dto.GetPublicRoomsResponse, error)
+}
@@ -0,0 +1,26 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type EventRepository interface {
+	FindAll(ctx context.Context, limit, offset int, sortBy, sortOrder, search string) ([]entity.Event, error)
+	Count(ctx context.Context, search string) (int64, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Event, error)
+	Create(ctx context.Context, event *entity.Event) error
+	Update(ctx context.Context, event *entity.Event) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type EventService interface {
+	GetEvents(ctx context.Context, query dto.GetEventsQuery) (dto.GetEventsResponse, error)
+	GetEvent(ctx context.Context, query dto.GetEventQuery) (dto.GetEventResponse, error)
+	CreateEvent(ctx context.Context, req dto.CreateEventRequest) error
+	UpdateEvent(ctx context.Context, req dto.UpdateEventRequest) error
+	DeleteEvent(ctx context.Context, query dto.DeleteEventQuery) error
+}
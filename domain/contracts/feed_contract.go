@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+)
+
+type FeedService interface {
+	GetSessionFeed(ctx context.Context, query dto.GetSessionFeedQuery) (dto.FeedResponse, error)
+}
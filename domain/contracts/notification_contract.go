@@ -0,0 +1,22 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	FindAll(ctx context.Context, userID uuid.UUID, unread bool, limit, offset int) ([]entity.Notification, error)
+	Count(ctx context.Context, userID uuid.UUID, unread bool) (int64, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Notification, error)
+	CreateMany(ctx context.Context, notifications []entity.Notification) error
+	MarkAsRead(ctx context.Context, id uuid.UUID) error
+}
+
+type NotificationService interface {
+	GetNotifications(ctx context.Context, query dto.GetNotificationsQuery) (dto.GetNotificationsResponse, error)
+	ReadNotification(ctx context.Context, query dto.ReadNotificationQuery) error
+}
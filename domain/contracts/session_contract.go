@@ -6,10 +6,13 @@ import (
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/google/uuid"
 )
 
 type SessionRepository interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+
 	FindAll(
 		ctx context.Context,
 		limit, offset int,
@@ -21,6 +24,8 @@ type SessionRepository interface {
 		proposerID uuid.UUID,
 		status int16,
 		userID uuid.UUID,
+		viewerID uuid.UUID,
+		after *cursor.Cursor,
 	) ([]entity.Session, error)
 	Count(
 		ctx context.Context,
@@ -31,11 +36,34 @@ type SessionRepository interface {
 		afterAt time.Time,
 		proposerID uuid.UUID,
 		status int16,
+		userID uuid.UUID,
+		viewerID uuid.UUID,
 	) (int64, error)
+	CountFacets(
+		ctx context.Context,
+		search string,
+		sessionType int16,
+		tags int16,
+		beforeAt time.Time,
+		afterAt time.Time,
+		proposerID uuid.UUID,
+		status int16,
+		userID uuid.UUID,
+		viewerID uuid.UUID,
+	) ([]entity.SessionFacetCount, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	FindCandidates(ctx context.Context, userID uuid.UUID, afterAt time.Time, limit int) ([]entity.SessionCandidate, error)
+	FindHighlyRated(ctx context.Context, userID uuid.UUID, beforeAt time.Time, minRating float64) ([]entity.Session, error)
+	FindSpeakerSessions(ctx context.Context, proposerID uuid.UUID, includeHidden bool) ([]entity.Session, error)
+	FindPublished(ctx context.Context, limit int, sessionType int16, tags int16) ([]entity.Session, error)
 	Create(ctx context.Context, session *entity.Session) error
 	Update(ctx context.Context, session *entity.Session) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	FindAllDeleted(ctx context.Context, limit, offset int) ([]entity.Session, error)
+	CountDeleted(ctx context.Context) (int64, error)
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	CountAttendees(
 		ctx context.Context,
@@ -52,24 +80,151 @@ type SessionRepository interface {
 		userID uuid.UUID,
 		limit, offset int,
 		sortBy, sortOrder string,
+		after *cursor.Cursor,
 	) ([]entity.SessionAttendee, error)
 	CreateSessionAttendee(ctx context.Context, sessionAttendee *entity.SessionAttendee) error
 	UpdateSessionAttendee(ctx context.Context, sessionAttendee *entity.SessionAttendee) error
+	DeleteSessionAttendee(ctx context.Context, sessionID, userID uuid.UUID) error
+	ReleaseSessionAttendees(ctx context.Context, sessionID uuid.UUID) ([]uuid.UUID, error)
+	FindReleasedSessionAttendees(ctx context.Context, sessionID uuid.UUID) ([]entity.SessionAttendee, error)
+	StreamSessionAttendees(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		eventID uuid.UUID,
+		fn func(sessionAttendee entity.SessionAttendee) error,
+	) error
+	StreamSessions(ctx context.Context, status int16, eventID uuid.UUID, fn func(session entity.Session) error) error
+
+	CreateReviewReport(ctx context.Context, report *entity.ReviewReport) error
+	CountReviewReports(ctx context.Context, sessionID, userID, reporterID uuid.UUID) (int64, error)
+	FindReviewReports(ctx context.Context, sessionID, userID uuid.UUID) ([]entity.ReviewReport, error)
+	ResolveReviewReports(ctx context.Context, sessionID, userID uuid.UUID) error
+	FindFlaggedReviews(ctx context.Context, limit, offset int) ([]entity.SessionAttendee, error)
+	CountFlaggedReviews(ctx context.Context) (int64, error)
+	CreateReviewModerationLog(ctx context.Context, moderationLog *entity.ReviewModerationLog) error
+	FindReviewModerationLogs(ctx context.Context, sessionID, userID uuid.UUID) ([]entity.ReviewModerationLog, error)
+	CreateReviewRevision(ctx context.Context, revision *entity.ReviewRevision) error
+	FindReviewRevisions(ctx context.Context, sessionID, userID uuid.UUID) ([]entity.ReviewRevision, error)
+
+	CreateSessionInvite(ctx context.Context, invite *entity.SessionInvite) error
+	FindSessionInvites(ctx context.Context, sessionID uuid.UUID) ([]entity.SessionInvite, error)
+	FindSessionInviteByID(ctx context.Context, sessionID, id uuid.UUID) (*entity.SessionInvite, error)
+	FindUsableSessionInvite(ctx context.Context, sessionID uuid.UUID, code string) (*entity.SessionInvite, error)
+	UseSessionInvite(ctx context.Context, sessionID uuid.UUID, code string) error
+	DeleteSessionInvite(ctx context.Context, id uuid.UUID) error
+	IsSessionInvitee(ctx context.Context, sessionID, userID uuid.UUID) (bool, error)
+
+	LockSession(ctx context.Context, id uuid.UUID) error
+	CreateRegistrationRequest(ctx context.Context, request *entity.RegistrationRequest) error
+	UpdateRegistrationRequest(ctx context.Context, request *entity.RegistrationRequest) error
+	DeleteRegistrationRequest(ctx context.Context, sessionID, userID uuid.UUID) error
+	FindRegistrationRequest(ctx context.Context, sessionID, userID uuid.UUID) (*entity.RegistrationRequest, error)
+	FindRegistrationRequests(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		status int16,
+		limit, offset int,
+	) ([]entity.RegistrationRequest, error)
+	CountRegistrationRequests(ctx context.Context, sessionID uuid.UUID, status int16) (int64, error)
+
+	FindRegistrationQuestions(ctx context.Context, sessionID uuid.UUID) ([]entity.RegistrationQuestion, error)
+	ReplaceRegistrationQuestions(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		questions []entity.RegistrationQuestion,
+	) error
+	SaveRegistrationAnswers(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		userID uuid.UUID,
+		answers []entity.RegistrationAnswer,
+	) error
+	FindRegistrationAnswers(ctx context.Context, sessionID uuid.UUID) ([]entity.RegistrationAnswer, error)
+	CountRegistrationAnswers(ctx context.Context, sessionID uuid.UUID) (int64, error)
+
+	FindSessionPrerequisites(ctx context.Context, sessionIDs []uuid.UUID) (map[uuid.UUID][]entity.Session, error)
+	ReplaceSessionPrerequisites(ctx context.Context, sessionID uuid.UUID, prerequisiteIDs []uuid.UUID) error
+	FindDependentSessions(ctx context.Context, prerequisiteID uuid.UUID) ([]entity.Session, error)
+	FindMissingPrerequisites(ctx context.Context, sessionID, userID uuid.UUID) ([]entity.Session, error)
+
+	LockUserRegistrations(ctx context.Context, userID uuid.UUID) error
+	FindHeldRegistrations(ctx context.Context, eventID, userID uuid.UUID) ([]entity.Session, error)
 }
 
 type SessionService interface {
 	GetSessions(ctx context.Context, query dto.GetSessionsQuery) (dto.GetSessionsResponse, error)
 	GetSession(ctx context.Context, query dto.GetSessionEventQuery) (dto.GetSessionEventResponse, error)
 	GetSessionAttendees(ctx context.Context, query dto.GetSessionAttendeesQuery) (dto.GetSessionAttendeesResponse, error)
+	GetRecommendations(ctx context.Context, query dto.GetRecommendationsQuery) (dto.GetRecommendationsResponse, error)
 	CreateSession(ctx context.Context, req dto.CreateSessionRequest) error
+	CloneSession(ctx context.Context, query dto.CloneSessionQuery, req dto.CloneSessionRequest) error
+	ImportSessions(ctx context.Context, req dto.ImportSessionsRequest) (dto.ImportSessionsResponse, error)
 	UpdateSession(ctx context.Context, req dto.UpdateSessionRequest) error
 	DeleteSession(ctx context.Context, query dto.DeleteSessionQuery) error
-	CancelSession(ctx context.Context, query dto.CancelSessionQuery) error
+	GetDeletedSessions(ctx context.Context, query dto.GetDeletedSessionsQuery) (dto.GetSessionsResponse, error)
+	RestoreSession(ctx context.Context, query dto.RestoreSessionQuery) error
+	CancelSession(ctx context.Context, query dto.CancelSessionQuery, req dto.CancelSessionRequest) error
+	RevertCancelSession(ctx context.Context, query dto.RevertCancelSessionQuery) error
 	AcceptSession(ctx context.Context, query dto.AcceptSessionQuery, req dto.AcceptSessionRequest) error
 	RejectSession(ctx context.Context, query dto.RejectSessionQuery, req dto.RejectSessionRequest) error
+	BulkAcceptSessions(ctx context.Context, req dto.BulkAcceptSessionsRequest) (dto.BulkSessionsResponse, error)
+	BulkRejectSessions(ctx context.Context, req dto.BulkRejectSessionsRequest) (dto.BulkSessionsResponse, error)
+	BulkCancelSessions(ctx context.Context, req dto.BulkCancelSessionsRequest) (dto.BulkSessionsResponse, error)
 
-	RegisterSession(ctx context.Context, query dto.RegisterSessionQuery, req dto.RegisterSessionRequest) error
+	RegisterSession(
+		ctx context.Context,
+		query dto.RegisterSessionQuery,
+		req dto.RegisterSessionRequest,
+	) (dto.RegisterSessionResponse, error)
 	UnregisterSession(ctx context.Context, query dto.UnregisterSessionQuery, req dto.UnregisterSessionRequest) error
+	ExportSessionAttendees(ctx context.Context, query dto.ExportSessionAttendeesQuery) (dto.ExportResponse, error)
+	ExportEventRegistrations(ctx context.Context, query dto.ExportEventRegistrationsQuery) (dto.ExportResponse, error)
+	ExportSessions(ctx context.Context, query dto.ExportSessionsQuery) (dto.ExportResponse, error)
+	SubscribeSessionSeats(ctx context.Context, query dto.SubscribeSessionSeatsQuery) (<-chan []byte, func(), error)
+	PublishSessionSeats(ctx context.Context, sessionIDs []uuid.UUID)
 	ReviewSession(ctx context.Context, query dto.ReviewSessionQuery, req dto.ReviewSessionRequest) error
 	DeleteReviewSession(ctx context.Context, query dto.DeleteReviewSessionQuery, req dto.DeleteReviewSessionRequest) error
+	EditReviewSession(ctx context.Context, query dto.EditReviewSessionQuery, req dto.EditReviewSessionRequest) error
+	GetReviewRevisions(ctx context.Context, query dto.GetReviewRevisionsQuery) (dto.GetReviewRevisionsResponse, error)
+	ReportReview(ctx context.Context, query dto.ReportReviewQuery, req dto.ReportReviewRequest) error
+	GetReviewReports(ctx context.Context, query dto.GetReviewReportsQuery) (dto.GetReviewReportsResponse, error)
+	ModerateReview(ctx context.Context, query dto.ModerateReviewQuery, req dto.ModerateReviewRequest) error
+	GetReviewModerationLogs(
+		ctx context.Context,
+		query dto.GetReviewModerationLogsQuery,
+	) (dto.GetReviewModerationLogsResponse, error)
+
+	GetSessionInvites(ctx context.Context, query dto.GetSessionInvitesQuery) (dto.GetSessionInvitesResponse, error)
+	CreateSessionInvite(
+		ctx context.Context,
+		query dto.CreateSessionInviteQuery,
+		req dto.CreateSessionInviteRequest,
+	) (dto.CreateSessionInviteResponse, error)
+	DeleteSessionInvite(ctx context.Context, query dto.DeleteSessionInviteQuery) error
+
+	GetRegistrationRequests(
+		ctx context.Context,
+		query dto.GetRegistrationRequestsQuery,
+	) (dto.GetRegistrationRequestsResponse, error)
+	ApproveRegistrations(
+		ctx context.Context,
+		query dto.DecideRegistrationsQuery,
+		req dto.ApproveRegistrationsRequest,
+	) (dto.BulkSessionsResponse, error)
+	DeclineRegistrations(
+		ctx context.Context,
+		query dto.DecideRegistrationsQuery,
+		req dto.DeclineRegistrationsRequest,
+	) (dto.BulkSessionsResponse, error)
+
+	UpdateRegistrationQuestions(
+		ctx context.Context,
+		query dto.UpdateRegistrationQuestionsQuery,
+		req dto.UpdateRegistrationQuestionsRequest,
+	) (dto.UpdateRegistrationQuestionsResponse, error)
+
+	GetRegistrationQuotas(
+		ctx context.Context,
+		query dto.GetRegistrationQuotasQuery,
+	) (dto.GetRegistrationQuotasResponse, error)
 }
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SessionPollRepository interface {
+	FindAll(ctx context.Context, sessionID uuid.UUID, includeDrafts bool) ([]entity.SessionPoll, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.SessionPoll, error)
+	Create(ctx context.Context, poll *entity.SessionPoll) error
+	Update(ctx context.Context, poll *entity.SessionPoll) error
+	CountVotes(ctx context.Context, pollID uuid.UUID, userID uuid.UUID) (int64, error)
+	CreateVote(ctx context.Context, vote *entity.SessionPollVote) error
+}
+
+type SessionPollService interface {
+	GetSessionPolls(ctx context.Context, query dto.GetSessionPollsQuery) (dto.GetSessionPollsResponse, error)
+	CreateSessionPoll(ctx context.Context, query dto.CreateSessionPollQuery, req dto.CreateSessionPollRequest) error
+	OpenSessionPoll(ctx context.Context, query dto.SessionPollQuery) error
+	CloseSessionPoll(ctx context.Context, query dto.SessionPollQuery) error
+	VoteSessionPoll(ctx context.Context, query dto.SessionPollQuery, req dto.VoteSessionPollRequest) error
+	SubscribeSessionPolls(ctx context.Context, query dto.SubscribeSessionPollsQuery) (<-chan []byte, func(), error)
+}
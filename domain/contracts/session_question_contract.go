@@ -0,0 +1,45 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SessionQuestionRepository interface {
+	FindAll(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		viewerID uuid.UUID,
+		includeHidden bool,
+		sortBy string,
+	) ([]entity.SessionQuestion, error)
+	FindByID(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*entity.SessionQuestion, error)
+	Create(ctx context.Context, question *entity.SessionQuestion) error
+	Update(ctx context.Context, question *entity.SessionQuestion) error
+	CountVotes(ctx context.Context, questionID uuid.UUID, userID uuid.UUID) (int64, error)
+	CreateVote(ctx context.Context, vote *entity.SessionQuestionVote) error
+	DeleteVote(ctx context.Context, questionID uuid.UUID, userID uuid.UUID) error
+}
+
+type SessionQuestionService interface {
+	GetSessionQuestions(
+		ctx context.Context,
+		query dto.GetSessionQuestionsQuery,
+	) (dto.GetSessionQuestionsResponse, error)
+	CreateSessionQuestion(
+		ctx context.Context,
+		query dto.CreateSessionQuestionQuery,
+		req dto.CreateSessionQuestionRequest,
+	) error
+	UpvoteSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error
+	RemoveUpvoteSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error
+	AnswerSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error
+	HideSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error
+	SubscribeSessionQuestions(
+		ctx context.Context,
+		query dto.SubscribeSessionQuestionsQuery,
+	) (<-chan []byte, func(), error)
+}
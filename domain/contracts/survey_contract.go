@@ -0,0 +1,41 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SurveyRepository interface {
+	FindAll(ctx context.Context, limit, offset int, eventID uuid.UUID, sessionType int16) ([]entity.SurveyTemplate, error)
+	Count(ctx context.Context, eventID uuid.UUID, sessionType int16) (int64, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.SurveyTemplate, error)
+	FindApplicable(ctx context.Context, eventID uuid.NullUUID, sessionType int16) ([]entity.SurveyTemplate, error)
+	Create(ctx context.Context, template *entity.SurveyTemplate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	CountResponses(ctx context.Context, templateID, sessionID, userID uuid.UUID) (int64, error)
+	CreateResponse(ctx context.Context, response *entity.SurveyResponse) error
+	FindResponses(ctx context.Context, templateID, sessionID uuid.UUID) ([]entity.SurveyResponse, error)
+	CountOptionAnswers(ctx context.Context, templateID, sessionID uuid.UUID) ([]entity.SurveyOptionCount, error)
+	SummarizeScaleAnswers(ctx context.Context, templateID, sessionID uuid.UUID) ([]entity.SurveyScaleSummary, error)
+	FindTextAnswers(ctx context.Context, templateID, sessionID uuid.UUID) ([]entity.SurveyAnswer, error)
+}
+
+type SurveyService interface {
+	GetSurveys(ctx context.Context, query dto.GetSurveysQuery) (dto.GetSurveysResponse, error)
+	GetSurvey(ctx context.Context, query dto.GetSurveyQuery) (dto.GetSurveyResponse, error)
+	CreateSurvey(ctx context.Context, req dto.CreateSurveyRequest) error
+	DeleteSurvey(ctx context.Context, query dto.DeleteSurveyQuery) error
+
+	GetSessionSurveys(ctx context.Context, query dto.GetSessionSurveysQuery) (dto.GetSessionSurveysResponse, error)
+	SubmitSurveyResponse(
+		ctx context.Context,
+		query dto.SubmitSurveyResponseQuery,
+		req dto.SubmitSurveyResponseRequest,
+	) error
+	GetSurveyResults(ctx context.Context, query dto.GetSurveyResultsQuery) (dto.GetSurveyResultsResponse, error)
+	ExportSurveyResults(ctx context.Context, query dto.GetSurveyResultsQuery) ([]byte, error)
+}
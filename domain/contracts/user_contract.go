@@ -2,26 +2,44 @@ package contracts
 
 import (
 	"context"
+	"time"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/google/uuid"
 )
 
 type UserRepository interface {
-	FindAll(ctx context.Context, limit, offset int, sortBy, sortOrder, search string, role int16) ([]entity.User, error)
+	FindAll(
+		ctx context.Context,
+		limit, offset int,
+		sortBy, sortOrder, search string,
+		role int16,
+		after *cursor.Cursor,
+	) ([]entity.User, error)
 	Count(ctx context.Context, search string, role int16) (int64, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	Create(ctx context.Context, user *entity.User) error
 	Update(ctx context.Context, user *entity.User) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+	FindAllDeleted(ctx context.Context, limit, offset int) ([]entity.User, error)
+	CountDeleted(ctx context.Context) (int64, error)
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	FindLinks(ctx context.Context, userID uuid.UUID) ([]entity.UserLink, error)
+	ReplaceLinks(ctx context.Context, userID uuid.UUID, links []entity.UserLink) error
 }
 
 type UserService interface {
 	GetUsers(ctx context.Context, query dto.GetUsersQuery) (dto.GetUsersResponse, error)
 	GetUser(ctx context.Context, query dto.GetUserQuery) (dto.GetUserResponse, error)
+	GetUserProfile(ctx context.Context, query dto.GetUserProfileQuery) (dto.GetUserProfileResponse, error)
 	CreateUser(ctx context.Context, req dto.CreateUserRequest) error
 	UpdateUser(ctx context.Context, req dto.UpdateUserRequest) error
 	DeleteUser(ctx context.Context, query dto.DeleteUserQuery) error
+	GetDeletedUsers(ctx context.Context, query dto.GetDeletedUsersQuery) (dto.GetDeletedUsersResponse, error)
+	RestoreUser(ctx context.Context, query dto.RestoreUserQuery) error
 }
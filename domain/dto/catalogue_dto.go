@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Speaker as the public catalogue shows them. Fields the speaker keeps to
+// coordinators are left out.
+type PublicSpeakerResponse struct {
+	ID          uuid.UUID          `json:"id"`
+	Name        string             `json:"name"`
+	ImageURI    *string            `json:"image_uri"`
+	Bio         string             `json:"bio,omitempty"`
+	Affiliation string             `json:"affiliation,omitempty"`
+	JobTitle    string             `json:"job_title,omitempty"`
+	Links       []UserLinkResponse `json:"links,omitempty"`
+}
+
+// Session as the public catalogue shows it. The meeting URL is only given to
+// registered attendees through the authenticated API.
+type PublicSessionResponse struct {
+	ID               uuid.UUID             `json:"id"`
+	Title            string                `json:"title"`
+	Description      string                `json:"description,omitempty"`
+	Type             int16                 `json:"type"`
+	Tags             []string              `json:"tags"`
+	StartAt          time.Time             `json:"start_at"`
+	EndAt            time.Time             `json:"end_at"`
+	TimeZone         string                `json:"time_zone"`
+	StartAtLocal     string                `json:"start_at_local"` // wall time in TimeZone
+	EndAtLocal       string                `json:"end_at_local"`
+	Room             string                `json:"room,omitempty"`
+	Capacity         int                   `json:"capacity"`
+	RequiresApproval bool                  `json:"requires_approval"`
+	ImageURI         string                `json:"image_uri,omitempty"`
+	EventID          uuid.NullUUID         `json:"event_id"`
+	Speaker          PublicSpeakerResponse `json:"speaker"`
+}
+
+type PublicRoomResponse struct {
+	Name          string `json:"name"`
+	CountSessions int64  `json:"count_sessions"`
+}
+
+type GetPublicSessionsQuery struct {
+	EventID   uuid.UUID `query:"event_id" validate:"omitempty,uuid"`
+	SpeakerID uuid.UUID `query:"speaker_id" validate:"omitempty,uuid"`
+	Tags      []string  `query:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	BeforeAt  time.Time `query:"before_at" validate:"omitempty"`
+	AfterAt   time.Time `query:"after_at" validate:"omitempty"`
+	Limit     int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page      int       `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetPublicSessionsResponse struct {
+	Sessions []PublicSessionResponse `json:"sessions"`
+	Meta     PaginationResponse      `json:"meta"`
+}
+
+type GetPublicSessionQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetPublicSessionResponse struct {
+	Session PublicSessionResponse `json:"session"`
+}
+
+type GetPublicSpeakersQuery struct {
+	EventID uuid.UUID `query:"event_id" validate:"omitempty,uuid"`
+	Limit   int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page    int       `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetPublicSpeakersResponse struct {
+	Speakers []PublicSpeakerResponse `json:"speakers"`
+	Meta     PaginationResponse      `json:"meta"`
+}
+
+type GetPublicSpeakerQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetPublicSpeakerResponse struct {
+	Speaker PublicSpeakerResponse `json:"speaker"`
+}
+
+type GetPublicRoomsQuery struct {
+	EventID uuid.UUID `query:"event_id" validate:"omitempty,uuid"`
+}
+
+type GetPublicRoomsResponse struct {
+	Rooms []PublicRoomResponse `json:"rooms"`
+}
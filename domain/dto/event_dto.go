@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventResponse struct {
+	ID                     uuid.UUID `json:"id"`
+	Name                   string    `json:"name"`
+	Description            string    `json:"description,omitempty"`
+	StartAt                time.Time `json:"start_at"`
+	EndAt                  time.Time `json:"end_at"`
+	TimeZone               string    `json:"time_zone"`
+	StartAtLocal           string    `json:"start_at_local"` // wall time in TimeZone
+	EndAtLocal             string    `json:"end_at_local"`
+	MaxRegistrations       *int      `json:"max_registrations,omitempty"`
+	MaxRegistrationsPerTag *int      `json:"max_registrations_per_tag,omitempty"`
+	MaxRegistrationsPerDay *int      `json:"max_registrations_per_day,omitempty"`
+}
+
+type GetEventsQuery struct {
+	Search    string `query:"search" validate:"omitempty,max=255"`
+	Limit     int    `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page      int    `query:"page" validate:"omitempty,numeric,min=1"`
+	SortBy    string `query:"sort_by" validate:"omitempty,oneof=id name start_at end_at"`
+	SortOrder string `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
+
+type GetEventsResponse struct {
+	Events []EventResponse    `json:"events"`
+	Meta   PaginationResponse `json:"meta"`
+}
+
+type GetEventQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetEventResponse struct {
+	Event EventResponse `json:"event"`
+}
+
+type CreateEventRequest struct {
+	Name                   string    `json:"name" validate:"required,min=3,max=255"`
+	Description            string    `json:"description" validate:"omitempty,max=1000"`
+	StartAt                time.Time `json:"start_at" validate:"required"`
+	EndAt                  time.Time `json:"end_at" validate:"required,gtefield=StartAt"`
+	TimeZone               string    `json:"time_zone" validate:"omitempty,timezone"`
+	MaxRegistrations       int       `json:"max_registrations" validate:"omitempty,numeric,min=1,max=100"` // per user, 0 means no limit
+	MaxRegistrationsPerTag int       `json:"max_registrations_per_tag" validate:"omitempty,numeric,min=1,max=100"`
+	MaxRegistrationsPerDay int       `json:"max_registrations_per_day" validate:"omitempty,numeric,min=1,max=100"`
+}
+
+type UpdateEventRequest struct {
+	ID                     uuid.UUID `param:"id" validate:"required,uuid"`
+	Name                   string    `json:"name" validate:"omitempty,min=3,max=255"`
+	Description            string    `json:"description" validate:"omitempty,max=1000"`
+	StartAt                time.Time `json:"start_at" validate:"omitempty"`
+	EndAt                  time.Time `json:"end_at" validate:"omitempty,gtefield=StartAt"`
+	TimeZone               string    `json:"time_zone" validate:"omitempty,timezone"`
+	MaxRegistrations       *int      `json:"max_registrations" validate:"omitempty,numeric,min=0,max=100"` // 0 removes the limit
+	MaxRegistrationsPerTag *int      `json:"max_registrations_per_tag" validate:"omitempty,numeric,min=0,max=100"`
+	MaxRegistrationsPerDay *int      `json:"max_registrations_per_day" validate:"omitempty,numeric,min=0,max=100"`
+}
+
+type DeleteEventQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import (
+	"context"
+	"io"
+)
+
+type ExportResponse struct {
+	Filename    string
+	ContentType string
+	Write       func(ctx context.Context, w io.Writer) error // streams the rows once the response is sent
+}
@@ -0,0 +1,15 @@
+package dto
+
+type GetSessionFeedQuery struct {
+	Format  string   `param:"format" validate:"required,oneof=atom rss json"`
+	Tags    []string `query:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	Type    int16    `query:"type" validate:"omitempty,numeric,oneof=1"`
+	Limit   int      `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	BaseURL string   // from config
+	FeedURL string   // from config and context
+}
+
+type FeedResponse struct {
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	Type      int16         `json:"type"`
+	Title     string        `json:"title"`
+	Body      string        `json:"body"`
+	SessionID uuid.NullUUID `json:"session_id"`
+	ReadAt    *time.Time    `json:"read_at"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type GetNotificationsQuery struct {
+	UserID uuid.UUID // from context
+	Unread bool      `query:"unread"`
+	Limit  int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page   int       `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Meta          PaginationResponse     `json:"meta"`
+}
+
+type ReadNotificationQuery struct {
+	UserID uuid.UUID // from context
+	ID     uuid.UUID `param:"id" validate:"required,uuid"`
+}
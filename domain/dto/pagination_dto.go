@@ -1,8 +1,12 @@
 package dto
 
+// Totals are left out when the client pages with cursors and did not ask
+// for them.
 type PaginationResponse struct {
-	TotalData int64 `json:"total_data"`
-	TotalPage int   `json:"total_page"`
-	Page      int   `json:"page"`
-	Limit     int   `json:"limit"`
+	TotalData  *int64 `json:"total_data,omitempty"`
+	TotalPage  *int   `json:"total_page,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
@@ -1,58 +1,104 @@
 package dto
 
 import (
+	"mime/multipart"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type SessionResponse struct {
-	ID             uuid.UUID    `json:"id"`
-	Title          string       `json:"title"`
-	Description    string       `json:"description,omitempty"`
-	Type           int16        `json:"type"`
-	Tags           []string     `json:"tags"`
-	StartAt        time.Time    `json:"start_at"`
-	EndAt          time.Time    `json:"end_at"`
-	Room           string       `json:"room,omitempty"`
-	Status         int16        `json:"status"`
-	MeetingURL     string       `json:"meeting_url,omitempty"`
-	Capacity       int          `json:"capacity"`
-	ImageURI       string       `json:"image_uri,omitempty"`
-	Proposer       UserResponse `json:"proposer"`
-	CountAttendees int64        `json:"count_attendees"`
+	ID                    uuid.UUID                      `json:"id"`
+	Title                 string                         `json:"title"`
+	Description           string                         `json:"description,omitempty"`
+	Type                  int16                          `json:"type"`
+	Tags                  []string                       `json:"tags"`
+	StartAt               time.Time                      `json:"start_at"`
+	EndAt                 time.Time                      `json:"end_at"`
+	TimeZone              string                         `json:"time_zone"`
+	StartAtLocal          string                         `json:"start_at_local"` // wall time in TimeZone
+	EndAtLocal            string                         `json:"end_at_local"`
+	Room                  string                         `json:"room,omitempty"`
+	Status                int16                          `json:"status"`
+	Visibility            int16                          `json:"visibility"`
+	MeetingURL            string                         `json:"meeting_url,omitempty"`
+	Capacity              int                            `json:"capacity"`
+	RequiresApproval      bool                           `json:"requires_approval"`
+	ImageURI              string                         `json:"image_uri,omitempty"`
+	Proposer              UserResponse                   `json:"proposer"`
+	CountAttendees        int64                          `json:"count_attendees"`
+	EventID               uuid.NullUUID                  `json:"event_id"`
+	CancelledAt           *time.Time                     `json:"cancelled_at,omitempty"`
+	CancelledReason       string                         `json:"cancelled_reason,omitempty"`
+	DeletedAt             *time.Time                     `json:"deleted_at,omitempty"`
+	Highlight             string                         `json:"highlight,omitempty"`
+	RegistrationQuestions []RegistrationQuestionResponse `json:"registration_questions,omitempty"`
+	Prerequisites         []SessionPrerequisiteResponse  `json:"prerequisites,omitempty"`
+}
+
+type SessionPrerequisiteResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	StartAt time.Time `json:"start_at"`
 }
 
 type SessionAttendeeResponse struct {
-	SessionID uuid.UUID       `json:"session_id"`
-	UserID    uuid.UUID       `json:"user_id"`
-	Review    string          `json:"review,omitempty"`
-	Reason    string          `json:"reason,omitempty"`
-	User      UserResponse    `json:"user"`
+	SessionID    uuid.UUID                    `json:"session_id"`
+	UserID       uuid.UUID                    `json:"user_id"`
+	Review       string                       `json:"review,omitempty"`
+	ReviewEdited bool                         `json:"review_edited"`
+	Reason       string                       `json:"reason,omitempty"`
+	User         UserResponse                 `json:"user"`
+	Answers      []RegistrationAnswerResponse `json:"answers,omitempty"` // only shown to the speaker and coordinators
 }
 
 type GetSessionsQuery struct {
-	Search     string    `query:"search" validate:"omitempty,max=255"`
-	Type       int16     `query:"type" validate:"omitempty,numeric,oneof= 1"`
-	Tags       []string  `query:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
-	Limit      int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
-	Page       int       `query:"page" validate:"omitempty,numeric,min=1"`
-	SortBy     string    `query:"sort_by" validate:"omitempty,oneof=id title start_at end_at room capacity"`
-	SortOrder  string    `query:"sort_order" validate:"omitempty,oneof=asc desc"`
-	BeforeAt   time.Time `query:"before_at" validate:"omitempty"`
-	AfterAt    time.Time `query:"after_at" validate:"omitempty"`
-	Status     int16     `query:"status" validate:"omitempty,numeric,oneof=1 2 3"`
-	ProposerID uuid.UUID `query:"proposer_id" validate:"omitempty,uuid"`
-	UserID     uuid.UUID `query:"user_id" validate:"omitempty,uuid"`
+	Search       string    `query:"search" validate:"omitempty,max=255"`
+	Type         int16     `query:"type" validate:"omitempty,numeric,oneof= 1"`
+	Tags         []string  `query:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	Limit        int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page         int       `query:"page" validate:"omitempty,numeric,min=1"`
+	SortBy       string    `query:"sort_by" validate:"omitempty,oneof=id title start_at end_at room capacity relevance"`
+	SortOrder    string    `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	BeforeAt     time.Time `query:"before_at" validate:"omitempty"`
+	AfterAt      time.Time `query:"after_at" validate:"omitempty"`
+	Status       int16     `query:"status" validate:"omitempty,numeric,oneof=1 2 3 4"`
+	ProposerID   uuid.UUID `query:"proposer_id" validate:"omitempty,uuid"`
+	UserID       uuid.UUID `query:"user_id" validate:"omitempty,uuid"`
+	Facets       bool      `query:"facets"`
+	Cursor       string    `query:"cursor"`
+	IncludeTotal *bool     `query:"include_total"` // defaults to false when paging with a cursor
+	ViewerID     uuid.UUID // from context
+	ViewerRole   int16     // from context
 }
 
 type GetSessionsResponse struct {
-	Sessions []SessionResponse  `json:"sessions"`
-	Meta     PaginationResponse `json:"meta"`
+	Sessions []SessionResponse      `json:"sessions"`
+	Meta     PaginationResponse     `json:"meta"`
+	Facets   *SessionFacetsResponse `json:"facets,omitempty"`
+}
+
+// Session counts per filter value under the current filters. Locations are
+// room names, or "online" for sessions with a meeting URL.
+type SessionFacetsResponse struct {
+	Tags      []SessionFacetCountResponse `json:"tags"`
+	Types     []SessionFacetCountResponse `json:"types"`
+	Statuses  []SessionFacetCountResponse `json:"statuses"`
+	Days      []SessionFacetCountResponse `json:"days"`
+	Locations []SessionFacetCountResponse `json:"locations"`
+}
+
+type SessionFacetCountResponse struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int64  `json:"count"`
 }
 
 type GetSessionEventQuery struct {
-	ID uuid.UUID `param:"id" validate:"required,uuid"`
+	ID         uuid.UUID `param:"id" validate:"required,uuid"`
+	InviteCode string    `query:"invite_code" validate:"omitempty,max=32"` // opens an invite-only session
+	ViewerID   uuid.UUID // from context
+	ViewerRole int16     // from context
 }
 
 type GetSessionEventResponse struct {
@@ -60,12 +106,16 @@ type GetSessionEventResponse struct {
 }
 
 type GetSessionAttendeesQuery struct {
-	ID        uuid.UUID `param:"id" validate:"required,uuid"`
-	Search    string    `query:"search" validate:"omitempty,max=255"`
-	Limit     int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
-	Page      int       `query:"page" validate:"omitempty,numeric,min=1"`
-	SortBy    string    `query:"sort_by" validate:"omitempty,oneof=id title start_at end_at capacity"`
-	SortOrder string    `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	ID           uuid.UUID `param:"id" validate:"required,uuid"`
+	Search       string    `query:"search" validate:"omitempty,max=255"`
+	Limit        int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page         int       `query:"page" validate:"omitempty,numeric,min=1"`
+	SortBy       string    `query:"sort_by" validate:"omitempty,oneof=id title start_at end_at capacity user_id"`
+	SortOrder    string    `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Cursor       string    `query:"cursor"`
+	IncludeTotal *bool     `query:"include_total"` // defaults to false when paging with a cursor
+	ViewerID     uuid.UUID // from context
+	ViewerRole   int16     // from context
 }
 
 type GetSessionAttendeesResponse struct {
@@ -74,49 +124,85 @@ type GetSessionAttendeesResponse struct {
 }
 
 type CreateSessionRequest struct {
-	ProposerID  uuid.UUID
-	Title       string    `json:"title" validate:"required,min=3,max=255"`
-	Description string    `json:"description" validate:"omitempty,max=255"`
-	Type        int16     `json:"type" validate:"required,numeric,oneof= 1"`
-	Tags        []string  `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
-	StartAt     time.Time `json:"start_at" validate:"required"`
-	EndAt       time.Time `json:"end_at" validate:"required,gtefield=StartAt"`
-	Room        string    `json:"room" validate:"omitempty,max=255"`
-	MeetingURL  string    `json:"meeting_url" validate:"omitempty,url"`
-	Capacity    int       `json:"capacity" validate:"required,numeric,min=1,max=100"`
+	ProposerID       uuid.UUID
+	Title            string      `json:"title" validate:"required,min=3,max=255"`
+	Description      string      `json:"description" validate:"omitempty,max=255"`
+	Type             int16       `json:"type" validate:"required,numeric,oneof= 1"`
+	Tags             []string    `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	StartAt          time.Time   `json:"start_at" validate:"required"`
+	EndAt            time.Time   `json:"end_at" validate:"required,gtefield=StartAt"`
+	Room             string      `json:"room" validate:"omitempty,max=255"`
+	MeetingURL       string      `json:"meeting_url" validate:"omitempty,url"`
+	Capacity         int         `json:"capacity" validate:"required,numeric,min=1,max=100"`
+	RequiresApproval bool        `json:"requires_approval"` // registrations wait for the speaker
+	EventID          uuid.UUID   `json:"event_id" validate:"omitempty,uuid"`
+	TimeZone         string      `json:"time_zone" validate:"omitempty,timezone"`             // ignored when the event sets one
+	Visibility       int16       `json:"visibility" validate:"omitempty,numeric,oneof=1 2 3"` // defaults to public
+	PrerequisiteIDs  []uuid.UUID `json:"prerequisite_ids" validate:"omitempty,max=10,unique,dive,required"`
+}
+
+type CloneSessionQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type CloneSessionRequest struct {
+	UserID     uuid.UUID // from context
+	Role       int16     // from context
+	StartAt    time.Time `json:"start_at" validate:"required"`
+	EndAt      time.Time `json:"end_at" validate:"required,gtefield=StartAt"`
+	Room       string    `json:"room" validate:"omitempty,max=255"`
+	MeetingURL string    `json:"meeting_url" validate:"omitempty,url"`
+	EventID    uuid.UUID `json:"event_id" validate:"omitempty,uuid"`
 }
 
 type UpdateSessionRequest struct {
-	ID          uuid.UUID `param:"id" validate:"required,uuid"`
-	Title       string    `json:"title" validate:"omitempty,min=3,max=255"`
-	Description string    `json:"description" validate:"omitempty,max=255"`
-	Type        int16     `json:"type" validate:"omitempty,numeric,oneof= 1"`
-	Tags        []string  `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
-	StartAt     time.Time `json:"start_at" validate:"omitempty"`
-	EndAt       time.Time `json:"end_at" validate:"omitempty,gtefield=StartAt"`
-	Room        string    `json:"room" validate:"omitempty,max=255"`
-	MeetingURL  string    `json:"meeting_url" validate:"omitempty,url"`
-	Capacity    int       `json:"capacity" validate:"omitempty,numeric,min=1,max=100"`
+	ID               uuid.UUID    `param:"id" validate:"required,uuid"`
+	Title            string       `json:"title" validate:"omitempty,min=3,max=255"`
+	Description      string       `json:"description" validate:"omitempty,max=255"`
+	Type             int16        `json:"type" validate:"omitempty,numeric,oneof= 1"`
+	Tags             []string     `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	StartAt          time.Time    `json:"start_at" validate:"omitempty"`
+	EndAt            time.Time    `json:"end_at" validate:"omitempty,gtefield=StartAt"`
+	Room             string       `json:"room" validate:"omitempty,max=255"`
+	MeetingURL       string       `json:"meeting_url" validate:"omitempty,url"`
+	Capacity         int          `json:"capacity" validate:"omitempty,numeric,min=1,max=100"`
+	RequiresApproval *bool        `json:"requires_approval"`
+	EventID          uuid.UUID    `json:"event_id" validate:"omitempty,uuid"`
+	TimeZone         string       `json:"time_zone" validate:"omitempty,timezone"` // ignored when the event sets one
+	Visibility       int16        `json:"visibility" validate:"omitempty,numeric,oneof=1 2 3"`
+	PrerequisiteIDs  *[]uuid.UUID `json:"prerequisite_ids" validate:"omitempty,max=10,unique,dive,required"` // replaces the list, empty clears it
 }
 
 type DeleteSessionQuery struct {
 	ID uuid.UUID `param:"id" validate:"required,uuid"`
 }
 
+type GetDeletedSessionsQuery struct {
+	Limit int `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page  int `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type RestoreSessionQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
 type AcceptSessionQuery struct {
 	ID uuid.UUID `param:"id" validate:"required,uuid"`
 }
 
 type AcceptSessionRequest struct {
-	Title       string    `json:"title" validate:"omitempty,min=3,max=255"`
-	Description string    `json:"description" validate:"omitempty,max=255"`
-	Type        int16     `json:"type" validate:"omitempty,numeric,oneof= 1"`
-	Tags        []string  `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
-	StartAt     time.Time `json:"start_at" validate:"omitempty"`
-	EndAt       time.Time `json:"end_at" validate:"omitempty,gtefield=StartAt"`
-	Room        string    `json:"room" validate:"omitempty,max=255"`
-	MeetingURL  string    `json:"meeting_url" validate:"omitempty,url"`
-	Capacity    int       `json:"capacity" validate:"omitempty,numeric,min=1,max=100"`
+	Title            string    `json:"title" validate:"omitempty,min=3,max=255"`
+	Description      string    `json:"description" validate:"omitempty,max=255"`
+	Type             int16     `json:"type" validate:"omitempty,numeric,oneof= 1"`
+	Tags             []string  `json:"tags" validate:"omitempty,dive,oneof=PM PD FE BE DS CP"`
+	StartAt          time.Time `json:"start_at" validate:"omitempty"`
+	EndAt            time.Time `json:"end_at" validate:"omitempty,gtefield=StartAt"`
+	Room             string    `json:"room" validate:"omitempty,max=255"`
+	MeetingURL       string    `json:"meeting_url" validate:"omitempty,url"`
+	Capacity         int       `json:"capacity" validate:"omitempty,numeric,min=1,max=100"`
+	RequiresApproval *bool     `json:"requires_approval"`
+	EventID          uuid.UUID `json:"event_id" validate:"omitempty,uuid"`
+	Visibility       int16     `json:"visibility" validate:"omitempty,numeric,oneof=1 2 3"`
 }
 
 type RejectSessionQuery struct {
@@ -127,12 +213,104 @@ type RejectSessionRequest struct {
 	Reason string `json:"reason" validate:"required,min=3,max=255"`
 }
 
+type ExportSessionAttendeesQuery struct {
+	ID      uuid.UUID `param:"id" validate:"required,uuid"`
+	UserID  uuid.UUID // from context
+	Role    int16     // from context
+	Format  string    `query:"format" validate:"omitempty,oneof=csv xlsx ndjson"`
+	Columns string    `query:"columns" validate:"omitempty,max=2048"` // room for question columns
+}
+
+type ExportEventRegistrationsQuery struct {
+	ID      uuid.UUID `param:"id" validate:"required,uuid"`
+	Role    int16     // from context
+	Format  string    `query:"format" validate:"omitempty,oneof=csv xlsx ndjson"`
+	Columns string    `query:"columns" validate:"omitempty,max=255"`
+}
+
+type ExportSessionsQuery struct {
+	Role    int16     // from context
+	Status  int16     `query:"status" validate:"omitempty,numeric,oneof=1 2 3 4"`
+	EventID uuid.UUID `query:"event_id" validate:"omitempty,uuid"`
+	Format  string    `query:"format" validate:"omitempty,oneof=csv xlsx ndjson"`
+	Columns string    `query:"columns" validate:"omitempty,max=255"`
+}
+
+type ImportSessionsRequest struct {
+	File    *multipart.FileHeader // from form file
+	Mapping string                `form:"mapping" validate:"omitempty,json"`
+	Commit  bool                  `form:"commit"`
+}
+
+type ImportSessionsRow struct {
+	Row           int               `json:"row"`
+	Title         string            `json:"title"`
+	ProposerEmail string            `json:"proposer_email"`
+	StartAt       time.Time         `json:"start_at"`
+	EndAt         time.Time         `json:"end_at"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
+type ImportSessionsResponse struct {
+	Committed   bool                `json:"committed"`
+	TotalRows   int                 `json:"total_rows"`
+	ValidRows   int                 `json:"valid_rows"`
+	InvalidRows int                 `json:"invalid_rows"`
+	Rows        []ImportSessionsRow `json:"rows"`
+}
+
+type BulkSessionsRequest struct {
+	IDs    []uuid.UUID `json:"ids" validate:"required,min=1,max=200,unique,dive,required"`
+	Mode   string      `json:"mode" validate:"omitempty,oneof=atomic per_item"`
+	DryRun bool        `json:"dry_run"`
+}
+
+type BulkAcceptSessionsRequest struct {
+	BulkSessionsRequest
+	Overrides AcceptSessionRequest `json:"overrides"`
+}
+
+type BulkRejectSessionsRequest struct {
+	BulkSessionsRequest
+	Reason string `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type BulkCancelSessionsRequest struct {
+	BulkSessionsRequest
+	Reason string `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type BulkSessionsResult struct {
+	ID         uuid.UUID `json:"id"`
+	Status     string    `json:"status"` // succeeded, failed, rolled_back or skipped
+	Success    bool      `json:"success"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+type BulkSessionsResponse struct {
+	Mode       string               `json:"mode"`
+	DryRun     bool                 `json:"dry_run"`
+	Committed  bool                 `json:"committed"`
+	Succeeded  int                  `json:"succeeded"`
+	Failed     int                  `json:"failed"`
+	RolledBack int                  `json:"rolled_back"`
+	Skipped    int                  `json:"skipped"`
+	Results    []BulkSessionsResult `json:"results"`
+}
+
 type RegisterSessionQuery struct {
 	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
 }
 
 type RegisterSessionRequest struct {
-	UserID uuid.UUID // from context
+	UserID     uuid.UUID                   // from context
+	InviteCode string                      `json:"invite_code" validate:"omitempty,max=32"` // needed for invite-only sessions
+	Answers    []RegistrationAnswerRequest `json:"answers" validate:"omitempty,max=50,dive"`
+}
+
+type RegisterSessionResponse struct {
+	Status string `json:"status"` // registered, or pending when the session requires approval
 }
 
 type UnregisterSessionQuery struct {
@@ -153,15 +331,289 @@ type ReviewSessionRequest struct {
 	Review string    `json:"review" validate:"required,min=3,max=255"`
 }
 
+type EditReviewSessionQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
+
+type EditReviewSessionRequest struct {
+	UserID uuid.UUID // from context
+	Review string    `json:"review" validate:"required,min=3,max=255"`
+}
+
+type GetReviewRevisionsQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	UserID    uuid.UUID `param:"userID" validate:"required,uuid"`
+}
+
+type ReviewRevisionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Review    string    `json:"review"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GetReviewRevisionsResponse struct {
+	Review    string                   `json:"review"`
+	EditedAt  *time.Time               `json:"edited_at"`
+	Revisions []ReviewRevisionResponse `json:"revisions"`
+}
+
 type DeleteReviewSessionQuery struct {
 	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
 	UserID    uuid.UUID `param:"userID" validate:"required,uuid"`
 }
 
 type DeleteReviewSessionRequest struct {
-	Reason string `json:"reason" validate:"required,min=3,max=255"`
+	ModeratorID uuid.UUID // from context
+	Reason      string    `json:"reason" validate:"required,min=3,max=255"`
 }
 
 type CancelSessionQuery struct {
 	ID uuid.UUID `param:"id" validate:"required,uuid"`
 }
+
+type CancelSessionRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type RevertCancelSessionQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type ReportReviewQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	UserID    uuid.UUID `param:"userID" validate:"required,uuid"`
+}
+
+type ReportReviewRequest struct {
+	ReporterID uuid.UUID // from context
+	Reason     string    `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type ReviewReportResponse struct {
+	ID        uuid.UUID    `json:"id"`
+	Reason    string       `json:"reason"`
+	CreatedAt time.Time    `json:"created_at"`
+	Reporter  UserResponse `json:"reporter"`
+}
+
+type FlaggedReviewResponse struct {
+	SessionID    uuid.UUID              `json:"session_id"`
+	UserID       uuid.UUID              `json:"user_id"`
+	Review       string                 `json:"review"`
+	SessionTitle string                 `json:"session_title"`
+	User         UserResponse           `json:"user"`
+	CountReports int64                  `json:"count_reports"`
+	Reports      []ReviewReportResponse `json:"reports"`
+}
+
+type GetReviewReportsQuery struct {
+	Limit int `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page  int `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetReviewReportsResponse struct {
+	Reviews []FlaggedReviewResponse `json:"reviews"`
+	Meta    PaginationResponse      `json:"meta"`
+}
+
+type ModerateReviewQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	UserID    uuid.UUID `param:"userID" validate:"required,uuid"`
+}
+
+type ModerateReviewRequest struct {
+	ModeratorID uuid.UUID // from context
+	Action      int16     `json:"action" validate:"required,numeric,oneof=1 2 3"`
+	Reason      string    `json:"reason" validate:"required,min=3,max=255"`
+}
+
+type GetReviewModerationLogsQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	UserID    uuid.UUID `param:"userID" validate:"required,uuid"`
+}
+
+type ReviewModerationLogResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ModeratorID uuid.UUID `json:"moderator_id"`
+	Action      int16     `json:"action"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type GetReviewModerationLogsResponse struct {
+	Logs []ReviewModerationLogResponse `json:"logs"`
+}
+
+type SessionSeatsResponse struct {
+	SessionID      uuid.UUID     `json:"session_id"`
+	EventID        uuid.NullUUID `json:"event_id"`
+	Capacity       int           `json:"capacity"`
+	CountAttendees int64         `json:"count_attendees"`
+	AvailableSeats int64         `json:"available_seats"`
+}
+
+type SubscribeSessionSeatsQuery struct {
+	SessionIDs []uuid.UUID `query:"session_ids" validate:"omitempty,max=50"`
+	EventID    uuid.UUID   `query:"event_id" validate:"omitempty,uuid"`
+	InviteCode string      `query:"invite_code" validate:"omitempty,max=32"` // opens an invite-only session
+	ViewerID   uuid.UUID   // from context
+	ViewerRole int16       // from context
+}
+
+type GetRecommendationsQuery struct {
+	UserID uuid.UUID // from context
+	Limit  int       `query:"limit" validate:"omitempty,numeric,min=1,max=50"`
+}
+
+type RecommendationResponse struct {
+	Session SessionResponse `json:"session"`
+	Score   float64         `json:"score"`
+	Reason  string          `json:"reason"`
+}
+
+type GetRecommendationsResponse struct {
+	Recommendations []RecommendationResponse `json:"recommendations"`
+}
+
+type SessionInviteResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Code        string     `json:"code,omitempty"`
+	UserID      *uuid.UUID `json:"user_id,omitempty"`
+	EmailDomain string     `json:"email_domain,omitempty"`
+	MaxUses     *int       `json:"max_uses,omitempty"`
+	Uses        int        `json:"uses"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type GetSessionInvitesQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetSessionInvitesResponse struct {
+	Invites []SessionInviteResponse `json:"invites"`
+}
+
+type CreateSessionInviteQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+// A code is generated for the "code" type, MaxUses and ExpiresAt only apply
+// to codes.
+type CreateSessionInviteRequest struct {
+	Type        string    `json:"type" validate:"required,oneof=code user email_domain"`
+	UserID      uuid.UUID `json:"user_id" validate:"required_if=Type user"`
+	EmailDomain string    `json:"email_domain" validate:"required_if=Type email_domain,omitempty,fqdn"`
+	MaxUses     int       `json:"max_uses" validate:"omitempty,numeric,min=1"`
+	ExpiresAt   time.Time `json:"expires_at" validate:"omitempty"`
+}
+
+type CreateSessionInviteResponse struct {
+	Invite SessionInviteResponse `json:"invite"`
+}
+
+type DeleteSessionInviteQuery struct {
+	ID       uuid.UUID `param:"id" validate:"required,uuid"`
+	InviteID uuid.UUID `param:"inviteID" validate:"required,uuid"`
+}
+
+type RegistrationRequestResponse struct {
+	UserID    uuid.UUID                    `json:"user_id"`
+	Status    int16                        `json:"status"`
+	Reason    string                       `json:"reason,omitempty"`
+	DecidedAt *time.Time                   `json:"decided_at,omitempty"`
+	CreatedAt time.Time                    `json:"created_at"`
+	User      UserResponse                 `json:"user"`
+	Answers   []RegistrationAnswerResponse `json:"answers,omitempty"`
+}
+
+type GetRegistrationRequestsQuery struct {
+	ID     uuid.UUID `param:"id" validate:"required,uuid"`
+	Status int16     `query:"status" validate:"omitempty,numeric,oneof=1 2 3"` // defaults to pending
+	Limit  int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page   int       `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetRegistrationRequestsResponse struct {
+	RegistrationRequests []RegistrationRequestResponse `json:"registration_requests"`
+	Meta                 PaginationResponse            `json:"meta"`
+}
+
+type DecideRegistrationsQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+// The IDs of the embedded request are the applicants' user IDs.
+type ApproveRegistrationsRequest struct {
+	BulkSessionsRequest
+	DeciderID uuid.UUID // from context
+}
+
+type DeclineRegistrationsRequest struct {
+	BulkSessionsRequest
+	Reason    string    `json:"reason" validate:"omitempty,max=255"`
+	DeciderID uuid.UUID // from context
+}
+
+type RegistrationQuestionOptionResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+}
+
+type RegistrationQuestionResponse struct {
+	ID       uuid.UUID                            `json:"id"`
+	Type     int16                                `json:"type"`
+	Prompt   string                               `json:"prompt"`
+	Required bool                                 `json:"required"`
+	Options  []RegistrationQuestionOptionResponse `json:"options,omitempty"`
+}
+
+type RegistrationAnswerResponse struct {
+	QuestionID uuid.UUID `json:"question_id"`
+	Prompt     string    `json:"prompt"`
+	Text       string    `json:"text,omitempty"`
+	Options    []string  `json:"options,omitempty"` // labels of the chosen options
+}
+
+type RegistrationQuestionRequest struct {
+	Type     int16    `json:"type" validate:"required,numeric,oneof=1 2 3"`
+	Prompt   string   `json:"prompt" validate:"required,min=3,max=255"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options" validate:"omitempty,max=20,dive,min=1,max=255"`
+}
+
+type UpdateRegistrationQuestionsQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+// Replaces the whole form, an empty list removes it.
+type UpdateRegistrationQuestionsRequest struct {
+	Questions []RegistrationQuestionRequest `json:"questions" validate:"omitempty,max=50,dive"`
+}
+
+type UpdateRegistrationQuestionsResponse struct {
+	RegistrationQuestions []RegistrationQuestionResponse `json:"registration_questions"`
+}
+
+type RegistrationAnswerRequest struct {
+	QuestionID uuid.UUID   `json:"question_id" validate:"required,uuid"`
+	OptionIDs  []uuid.UUID `json:"option_ids" validate:"omitempty,max=20"`
+	Text       string      `json:"text" validate:"omitempty,max=1000"`
+}
+
+type GetRegistrationQuotasQuery struct {
+	EventID uuid.UUID `param:"id" validate:"required,uuid"`
+	UserID  uuid.UUID // from context
+}
+
+type RegistrationQuotaResponse struct {
+	Quota string `json:"quota"`         // total, tag or day
+	Key   string `json:"key,omitempty"` // the tag, or the day in the event's time zone
+	Used  int    `json:"used"`
+	Limit int    `json:"limit"`
+}
+
+type GetRegistrationQuotasResponse struct {
+	EventID uuid.UUID                   `json:"event_id"`
+	Quotas  []RegistrationQuotaResponse `json:"quotas"`
+}
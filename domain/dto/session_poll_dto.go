@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionPollOptionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Label      string    `json:"label"`
+	CountVotes int64     `json:"count_votes"`
+}
+
+type SessionPollResponse struct {
+	ID         uuid.UUID                   `json:"id"`
+	SessionID  uuid.UUID                   `json:"session_id"`
+	Question   string                      `json:"question"`
+	Status     int16                       `json:"status"`
+	CountVotes int64                       `json:"count_votes"`
+	Voted      bool                        `json:"voted"`
+	Options    []SessionPollOptionResponse `json:"options"`
+	OpenedAt   *time.Time                  `json:"opened_at"`
+	ClosedAt   *time.Time                  `json:"closed_at"`
+	CreatedAt  time.Time                   `json:"created_at"`
+}
+
+type SessionPollEventResponse struct {
+	Type string              `json:"type"`
+	Poll SessionPollResponse `json:"poll"`
+}
+
+type GetSessionPollsQuery struct {
+	UserID    uuid.UUID // from context
+	Role      int16     // from context
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
+
+type GetSessionPollsResponse struct {
+	Polls []SessionPollResponse `json:"polls"`
+}
+
+type CreateSessionPollQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
+
+type CreateSessionPollRequest struct {
+	UserID   uuid.UUID // from context
+	Question string    `json:"question" validate:"required,min=3,max=255"`
+	Options  []string  `json:"options" validate:"required,min=2,max=10,dive,required,max=255"`
+}
+
+type SessionPollQuery struct {
+	UserID    uuid.UUID // from context
+	Role      int16     // from context
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	PollID    uuid.UUID `param:"pollID" validate:"required,uuid"`
+}
+
+type VoteSessionPollRequest struct {
+	OptionID uuid.UUID `json:"option_id" validate:"required,uuid"`
+}
+
+type SubscribeSessionPollsQuery struct {
+	UserID    uuid.UUID // from context
+	Role      int16     // from context
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionQuestionResponse struct {
+	ID           uuid.UUID    `json:"id"`
+	SessionID    uuid.UUID    `json:"session_id"`
+	Content      string       `json:"content,omitempty"`
+	Status       int16        `json:"status"`
+	CountUpvotes int64        `json:"count_upvotes"`
+	Upvoted      bool         `json:"upvoted"`
+	User         UserResponse `json:"user"`
+	AnsweredAt   *time.Time   `json:"answered_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type SessionQuestionEventResponse struct {
+	Type     string                  `json:"type"`
+	Question SessionQuestionResponse `json:"question"`
+}
+
+type GetSessionQuestionsQuery struct {
+	UserID    uuid.UUID // from context
+	Role      int16     // from context
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	SortBy    string    `query:"sort_by" validate:"omitempty,oneof=top recent"`
+}
+
+type GetSessionQuestionsResponse struct {
+	Questions []SessionQuestionResponse `json:"questions"`
+}
+
+type CreateSessionQuestionQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
+
+type CreateSessionQuestionRequest struct {
+	UserID  uuid.UUID // from context
+	Content string    `json:"content" validate:"required,min=3,max=500"`
+}
+
+type SessionQuestionQuery struct {
+	UserID     uuid.UUID // from context
+	Role       int16     // from context
+	SessionID  uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	QuestionID uuid.UUID `param:"questionID" validate:"required,uuid"`
+}
+
+type SubscribeSessionQuestionsQuery struct {
+	UserID    uuid.UUID // from context
+	Role      int16     // from context
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SurveyQuestionOptionResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+}
+
+type SurveyQuestionResponse struct {
+	ID       uuid.UUID                      `json:"id"`
+	Type     int16                          `json:"type"`
+	Prompt   string                         `json:"prompt"`
+	Required bool                           `json:"required"`
+	ScaleMin *int32                         `json:"scale_min,omitempty"`
+	ScaleMax *int32                         `json:"scale_max,omitempty"`
+	Options  []SurveyQuestionOptionResponse `json:"options,omitempty"`
+}
+
+type SurveyResponse struct {
+	ID          uuid.UUID                `json:"id"`
+	Title       string                   `json:"title"`
+	Description string                   `json:"description,omitempty"`
+	EventID     uuid.NullUUID            `json:"event_id"`
+	SessionType int16                    `json:"session_type,omitempty"`
+	CreatedAt   time.Time                `json:"created_at"`
+	Questions   []SurveyQuestionResponse `json:"questions,omitempty"`
+}
+
+type GetSurveysQuery struct {
+	EventID     uuid.UUID `query:"event_id" validate:"omitempty,uuid"`
+	SessionType int16     `query:"session_type" validate:"omitempty,numeric,oneof=1"`
+	Limit       int       `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page        int       `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetSurveysResponse struct {
+	Surveys []SurveyResponse   `json:"surveys"`
+	Meta    PaginationResponse `json:"meta"`
+}
+
+type GetSurveyQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetSurveyResponse struct {
+	Survey SurveyResponse `json:"survey"`
+}
+
+type CreateSurveyQuestionRequest struct {
+	Type     int16    `json:"type" validate:"required,numeric,oneof=1 2 3 4"`
+	Prompt   string   `json:"prompt" validate:"required,min=3,max=255"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options" validate:"omitempty,max=20,dive,min=1,max=255"`
+	ScaleMin int32    `json:"scale_min" validate:"omitempty,numeric,min=0,max=10"`
+	ScaleMax int32    `json:"scale_max" validate:"omitempty,numeric,min=1,max=10"`
+}
+
+type CreateSurveyRequest struct {
+	CreatedBy   uuid.UUID                     // from context
+	Title       string                        `json:"title" validate:"required,min=3,max=255"`
+	Description string                        `json:"description" validate:"omitempty,max=1000"`
+	EventID     uuid.UUID                     `json:"event_id" validate:"omitempty,uuid"`
+	SessionType int16                         `json:"session_type" validate:"omitempty,numeric,oneof=1"`
+	Questions   []CreateSurveyQuestionRequest `json:"questions" validate:"required,min=1,max=50,dive"`
+}
+
+type DeleteSurveyQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
+
+type GetSessionSurveysQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+}
+
+type GetSessionSurveysResponse struct {
+	Surveys []SurveyResponse `json:"surveys"`
+}
+
+type SurveyAnswerRequest struct {
+	QuestionID uuid.UUID   `json:"question_id" validate:"required,uuid"`
+	OptionIDs  []uuid.UUID `json:"option_ids" validate:"omitempty,max=20"`
+	ScaleValue *int32      `json:"scale_value" validate:"omitempty"`
+	Text       string      `json:"text" validate:"omitempty,max=1000"`
+}
+
+type SubmitSurveyResponseQuery struct {
+	SessionID uuid.UUID `param:"sessionID" validate:"required,uuid"`
+	SurveyID  uuid.UUID `param:"surveyID" validate:"required,uuid"`
+}
+
+type SubmitSurveyResponseRequest struct {
+	UserID  uuid.UUID             // from context
+	Answers []SurveyAnswerRequest `json:"answers" validate:"required,min=1,dive"`
+}
+
+type GetSurveyResultsQuery struct {
+	ID        uuid.UUID `param:"id" validate:"required,uuid"`
+	SessionID uuid.UUID `query:"session_id" validate:"omitempty,uuid"`
+}
+
+type SurveyOptionResultResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Label string    `json:"label"`
+	Count int64     `json:"count"`
+}
+
+type SurveyQuestionResultResponse struct {
+	ID           uuid.UUID                    `json:"id"`
+	Type         int16                        `json:"type"`
+	Prompt       string                       `json:"prompt"`
+	CountAnswers int64                        `json:"count_answers"`
+	Options      []SurveyOptionResultResponse `json:"options,omitempty"`
+	Average      *float64                     `json:"average,omitempty"`
+	Texts        []string                     `json:"texts,omitempty"`
+}
+
+type GetSurveyResultsResponse struct {
+	Survey         SurveyResponse                 `json:"survey"`
+	CountResponses int64                          `json:"count_responses"`
+	Questions      []SurveyQuestionResultResponse `json:"questions"`
+}
@@ -1,22 +1,31 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type UserResponse struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Email    string    `json:"email"`
-	Role     int16     `json:"role"`
-	ImageURI *string   `json:"image_uri"`
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email,omitempty"` // left out when the user hides it
+	Role      int16      `json:"role"`
+	ImageURI  *string    `json:"image_uri"`
+	Interests []string   `json:"interests,omitempty"`
+	TimeZone  string     `json:"time_zone,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 }
 
 type GetUsersQuery struct {
-	Role      int16  `query:"role" validate:"omitempty,numeric,min=0,max=2"`
-	Search    string `query:"search"`
-	Limit     int    `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
-	Page      int    `query:"page" validate:"omitempty,numeric,min=1"`
-	SortOrder string `query:"sort_order" validate:"omitempty,oneof=asc desc"`
-	SortBy    string `query:"sort_by" validate:"omitempty,oneof=id name email role created_at updated_at"`
+	Role         int16  `query:"role" validate:"omitempty,numeric,min=0,max=2"`
+	Search       string `query:"search"`
+	Limit        int    `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page         int    `query:"page" validate:"omitempty,numeric,min=1"`
+	SortOrder    string `query:"sort_order" validate:"omitempty,oneof=asc desc"`
+	SortBy       string `query:"sort_by" validate:"omitempty,oneof=id name email role created_at updated_at"`
+	Cursor       string `query:"cursor"`
+	IncludeTotal *bool  `query:"include_total"` // defaults to false when paging with a cursor
 }
 
 type GetUsersResponse struct {
@@ -25,7 +34,9 @@ type GetUsersResponse struct {
 }
 
 type GetUserQuery struct {
-	ID uuid.UUID `param:"id" validate:"required,uuid"`
+	ID         uuid.UUID `param:"id" validate:"required,uuid"`
+	ViewerID   uuid.UUID // from context
+	ViewerRole int16     // from context
 }
 
 type GetUserResponse struct {
@@ -39,11 +50,95 @@ type CreateUserRequest struct {
 }
 
 type UpdateUserRequest struct {
-	ID       uuid.UUID // from context
-	Name     string    `json:"name" validate:"omitempty,min=3,max=255"`
-	Password string    `json:"password" validate:"omitempty,min=8,max=255"`
+	ID          uuid.UUID                    // from context
+	Name        string                       `json:"name" validate:"omitempty,min=3,max=255"`
+	Password    string                       `json:"password" validate:"omitempty,min=8,max=255"`
+	Interests   []string                     `json:"interests" validate:"omitempty,unique,dive,oneof=PM PD FE BE DS CP"`
+	TimeZone    *string                      `json:"time_zone" validate:"omitempty,timezone"`  // an empty string clears it
+	Bio         *string                      `json:"bio" validate:"omitempty,max=2000"`        // an empty string clears it
+	Affiliation *string                      `json:"affiliation" validate:"omitempty,max=255"` // an empty string clears it
+	JobTitle    *string                      `json:"job_title" validate:"omitempty,max=255"`   // an empty string clears it
+	Links       *[]UserLinkRequest           `json:"links" validate:"omitempty,max=10,dive"`   // replaces all links
+	Privacy     *UpdateProfilePrivacyRequest `json:"privacy"`
+}
+
+type UserLinkRequest struct {
+	Label string `json:"label" validate:"required,max=50"`
+	URL   string `json:"url" validate:"required,max=2048,http_url"`
+}
+
+// Who can see each profile field: 1 for everyone, 2 for coordinators only.
+// Fields left out keep their setting.
+type UpdateProfilePrivacyRequest struct {
+	Email       int16 `json:"email" validate:"omitempty,oneof=1 2"`
+	Bio         int16 `json:"bio" validate:"omitempty,oneof=1 2"`
+	Affiliation int16 `json:"affiliation" validate:"omitempty,oneof=1 2"`
+	JobTitle    int16 `json:"job_title" validate:"omitempty,oneof=1 2"`
+	Links       int16 `json:"links" validate:"omitempty,oneof=1 2"`
+}
+
+type ProfilePrivacyResponse struct {
+	Email       string `json:"email"`
+	Bio         string `json:"bio"`
+	Affiliation string `json:"affiliation"`
+	JobTitle    string `json:"job_title"`
+	Links       string `json:"links"`
+}
+
+type UserLinkResponse struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+type SpeakerSessionResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Title         string    `json:"title"`
+	Tags          []string  `json:"tags"`
+	StartAt       time.Time `json:"start_at"`
+	EndAt         time.Time `json:"end_at"`
+	AverageRating *float64  `json:"average_rating"` // on a 1-5 scale, null until someone rates it
+	CountRatings  int64     `json:"count_ratings"`
+}
+
+type UserProfileResponse struct {
+	ID               uuid.UUID                `json:"id"`
+	Name             string                   `json:"name"`
+	Email            string                   `json:"email,omitempty"`
+	Role             int16                    `json:"role"`
+	ImageURI         *string                  `json:"image_uri"`
+	Bio              string                   `json:"bio,omitempty"`
+	Affiliation      string                   `json:"affiliation,omitempty"`
+	JobTitle         string                   `json:"job_title,omitempty"`
+	Links            []UserLinkResponse       `json:"links,omitempty"`
+	Privacy          *ProfilePrivacyResponse  `json:"privacy,omitempty"` // only shown to the user themselves
+	UpcomingSessions []SpeakerSessionResponse `json:"upcoming_sessions"`
+	PastSessions     []SpeakerSessionResponse `json:"past_sessions"`
+}
+
+type GetUserProfileQuery struct {
+	ID         uuid.UUID `param:"id" validate:"required,uuid"`
+	ViewerID   uuid.UUID // from context
+	ViewerRole int16     // from context
+}
+
+type GetUserProfileResponse struct {
+	Profile UserProfileResponse `json:"profile"`
 }
 
 type DeleteUserQuery struct {
 	ID uuid.UUID `param:"id" validate:"required,uuid"`
 }
+
+type GetDeletedUsersQuery struct {
+	Limit int `query:"limit" validate:"omitempty,numeric,min=1,max=100"`
+	Page  int `query:"page" validate:"omitempty,numeric,min=1"`
+}
+
+type GetDeletedUsersResponse struct {
+	Users []UserResponse     `json:"users"`
+	Meta  PaginationResponse `json:"meta"`
+}
+
+type RestoreUserQuery struct {
+	ID uuid.UUID `param:"id" validate:"required,uuid"`
+}
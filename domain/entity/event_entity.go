@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Event struct {
+	ID                     uuid.UUID      `db:"id" json:"id"`
+	Name                   string         `db:"name" json:"name"`
+	Description            sql.NullString `db:"description" json:"description"`
+	StartAt                time.Time      `db:"start_at" json:"start_at"`
+	EndAt                  time.Time      `db:"end_at" json:"end_at"`
+	TimeZone               string         `db:"time_zone" json:"time_zone"`
+	MaxRegistrations       sql.NullInt32  `db:"max_registrations" json:"max_registrations"`
+	MaxRegistrationsPerTag sql.NullInt32  `db:"max_registrations_per_tag" json:"max_registrations_per_tag"`
+	MaxRegistrationsPerDay sql.NullInt32  `db:"max_registrations_per_day" json:"max_registrations_per_day"`
+	CreatedAt              time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt              time.Time      `db:"updated_at" json:"updated_at"`
+}
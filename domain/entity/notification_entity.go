@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	UserID    uuid.UUID     `db:"user_id" json:"user_id"`
+	Type      int16         `db:"type" json:"type"`
+	Title     string        `db:"title" json:"title"`
+	Body      string        `db:"body" json:"body"`
+	SessionID uuid.NullUUID `db:"session_id" json:"session_id"`
+	ReadAt    sql.NullTime  `db:"read_at" json:"read_at"`
+	CreatedAt time.Time     `db:"created_at" json:"created_at"`
+}
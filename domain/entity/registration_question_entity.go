@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RegistrationQuestion struct {
+	ID        uuid.UUID                    `db:"id" json:"id"`
+	SessionID uuid.UUID                    `db:"session_id" json:"session_id"`
+	Position  int                          `db:"position" json:"position"`
+	Type      int16                        `db:"type" json:"type"`
+	Prompt    string                       `db:"prompt" json:"prompt"`
+	Required  bool                         `db:"required" json:"required"`
+	Options   []RegistrationQuestionOption `db:"-" json:"options"`
+}
+
+type RegistrationQuestionOption struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	Position   int       `db:"position" json:"position"`
+	Label      string    `db:"label" json:"label"`
+}
+
+type RegistrationAnswer struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	SessionID  uuid.UUID      `db:"session_id" json:"session_id"`
+	UserID     uuid.UUID      `db:"user_id" json:"user_id"`
+	QuestionID uuid.UUID      `db:"question_id" json:"question_id"`
+	OptionID   uuid.NullUUID  `db:"option_id" json:"option_id"`
+	TextValue  sql.NullString `db:"text_value" json:"text_value"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewReport struct {
+	ID         uuid.UUID    `db:"id" json:"id"`
+	SessionID  uuid.UUID    `db:"session_id" json:"session_id"`
+	UserID     uuid.UUID    `db:"user_id" json:"user_id"`
+	ReporterID uuid.UUID    `db:"reporter_id" json:"reporter_id"`
+	Reason     string       `db:"reason" json:"reason"`
+	ResolvedAt sql.NullTime `db:"resolved_at" json:"resolved_at"`
+	CreatedAt  time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at" json:"updated_at"`
+	Reporter   User         `db:"reporter" json:"reporter"`
+}
+
+type ReviewRevision struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	SessionID uuid.UUID `db:"session_id" json:"session_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	Review    string    `db:"review" json:"review"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+type ReviewModerationLog struct {
+	ID          uuid.UUID     `db:"id" json:"id"`
+	SessionID   uuid.UUID     `db:"session_id" json:"session_id"`
+	UserID      uuid.UUID     `db:"user_id" json:"user_id"`
+	ModeratorID uuid.NullUUID `db:"moderator_id" json:"moderator_id"`
+	Action      int16         `db:"action" json:"action"`
+	Reason      string        `db:"reason" json:"reason"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,8 @@
+package entity
+
+// A room as the public catalogue lists it, with the number of sessions held
+// there. Rooms are free text on sessions rather than a table of their own.
+type Room struct {
+	Name          string `db:"name" json:"name"`
+	CountSessions int64  `db:"count_sessions" json:"count_sessions"`
+}
@@ -2,7 +2,7 @@ package entity
 
 import (
 	"database/sql"
-	"strconv"
+	"fmt"
 	"time"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
@@ -17,31 +17,72 @@ type Session struct {
 	Type             int16             `db:"type" json:"type"`
 	Tags             int16             `db:"tags" json:"tags"`
 	Status           int16             `db:"status" json:"status"`
+	Visibility       int16             `db:"visibility" json:"visibility"`
 	StartAt          time.Time         `db:"start_at" json:"start_at"`
 	EndAt            time.Time         `db:"end_at" json:"end_at"`
+	TimeZone         string            `db:"time_zone" json:"time_zone"`
 	Room             sql.NullString    `db:"room" json:"room"`
 	MeetingURL       sql.NullString    `db:"meeting_url" json:"meeting_url"`
 	Capacity         int               `db:"capacity" json:"capacity"`
+	RequiresApproval bool              `db:"requires_approval" json:"requires_approval"`
 	ImageURI         sql.NullString    `db:"image_uri" json:"image_uri"`
 	CreatedAt        time.Time         `db:"created_at" json:"created_at"`
 	UpdatedAt        time.Time         `db:"updated_at" json:"updated_at"`
 	DeletedAt        sql.NullTime      `db:"deleted_at" json:"deleted_at"`
 	DeletedReason    sql.NullString    `db:"deleted_reason" json:"deleted_reason"`
+	EventID          uuid.NullUUID     `db:"event_id" json:"event_id"`
+	CancelledAt      sql.NullTime      `db:"cancelled_at" json:"cancelled_at"`
+	CancelledReason  sql.NullString    `db:"cancelled_reason" json:"cancelled_reason"`
+	ApprovedAt       sql.NullTime      `db:"approved_at" json:"approved_at"`
+	RescheduledAt    sql.NullTime      `db:"rescheduled_at" json:"rescheduled_at"`
+	CountAttendees   int64             `db:"count_attendees" json:"count_attendees"`
+	CountReviews     int64             `db:"count_reviews" json:"count_reviews"`
+	Highlight        sql.NullString    `db:"highlight" json:"highlight"`
+	AverageRating    sql.NullFloat64   `db:"average_rating" json:"average_rating"`
+	CountRatings     int64             `db:"count_ratings" json:"count_ratings"`
 	Proposer         User              `db:"proposer" json:"proposer"`
 }
 
 type SessionAttendee struct {
-	SessionID     uuid.UUID      `db:"session_id" json:"session_id"`
-	UserID        uuid.UUID      `db:"user_id" json:"user_id"`
-	Review        sql.NullString `db:"review" json:"review"`
-	Reason        sql.NullString `db:"reason" json:"reason"`
-	DeletedReason sql.NullString `db:"deleted_reason" json:"deleted_reason"`
-	User          User           `db:"user" json:"user"`
-	Session       Session        `db:"session" json:"session"`
+	SessionID      uuid.UUID      `db:"session_id" json:"session_id"`
+	UserID         uuid.UUID      `db:"user_id" json:"user_id"`
+	Review         sql.NullString `db:"review" json:"review"`
+	Reason         sql.NullString `db:"reason" json:"reason"`
+	DeletedReason  sql.NullString `db:"deleted_reason" json:"deleted_reason"`
+	ReviewEditedAt sql.NullTime   `db:"review_edited_at" json:"review_edited_at"`
+	ReleasedAt     sql.NullTime   `db:"released_at" json:"released_at"`
+	User           User           `db:"user" json:"user"`
+	Session        Session        `db:"session" json:"session"`
+	CountReports   int64          `db:"count_reports" json:"count_reports"`
+}
+
+type RegistrationRequest struct {
+	SessionID uuid.UUID      `db:"session_id" json:"session_id"`
+	UserID    uuid.UUID      `db:"user_id" json:"user_id"`
+	Status    int16          `db:"status" json:"status"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	DecidedBy uuid.NullUUID  `db:"decided_by" json:"decided_by"`
+	DecidedAt sql.NullTime   `db:"decided_at" json:"decided_at"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+	User      User           `db:"user" json:"user"`
+}
+
+// An upcoming session the user could still register for. CoAttendees counts
+// the registrants who also attended another session with the user.
+type SessionCandidate struct {
+	Session
+	CoAttendees int64 `db:"co_attendees"`
+}
+
+type SessionFacetCount struct {
+	Facet string `db:"facet"`
+	Value string `db:"value"`
+	Count int64  `db:"count"`
 }
 
 func (s *Session) TagsArray() []string {
-	binary := strconv.FormatInt(int64(s.Tags), 2)
+	// pad to the six tag bits so leading unset tags keep their position
+	binary := fmt.Sprintf("%06b", s.Tags)
 
 	// convert binary to array of tags
 	tags := []string{}
@@ -53,3 +94,9 @@ func (s *Session) TagsArray() []string {
 
 	return tags
 }
+
+func (s *Session) IsRunning() bool {
+	now := time.Now()
+
+	return !s.StartAt.After(now) && s.EndAt.After(now)
+}
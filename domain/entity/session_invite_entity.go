@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Grants access to an invite-only session. Exactly one of Code, UserID and
+// EmailDomain is set.
+type SessionInvite struct {
+	ID          uuid.UUID      `db:"id" json:"id"`
+	SessionID   uuid.UUID      `db:"session_id" json:"session_id"`
+	Code        sql.NullString `db:"code" json:"code"`
+	UserID      uuid.NullUUID  `db:"user_id" json:"user_id"`
+	EmailDomain sql.NullString `db:"email_domain" json:"email_domain"`
+	MaxUses     sql.NullInt32  `db:"max_uses" json:"max_uses"`
+	Uses        int            `db:"uses" json:"uses"`
+	ExpiresAt   sql.NullTime   `db:"expires_at" json:"expires_at"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+}
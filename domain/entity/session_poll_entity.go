@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionPoll struct {
+	ID        uuid.UUID           `db:"id" json:"id"`
+	SessionID uuid.UUID           `db:"session_id" json:"session_id"`
+	Question  string              `db:"question" json:"question"`
+	Status    int16               `db:"status" json:"status"`
+	OpenedAt  sql.NullTime        `db:"opened_at" json:"opened_at"`
+	ClosedAt  sql.NullTime        `db:"closed_at" json:"closed_at"`
+	CreatedAt time.Time           `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time           `db:"updated_at" json:"updated_at"`
+	Options   []SessionPollOption `db:"-" json:"options"`
+}
+
+type SessionPollOption struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	PollID     uuid.UUID `db:"poll_id" json:"poll_id"`
+	Position   int       `db:"position" json:"position"`
+	Label      string    `db:"label" json:"label"`
+	CountVotes int64     `db:"count_votes" json:"count_votes"`
+}
+
+type SessionPollVote struct {
+	PollID    uuid.UUID `db:"poll_id" json:"poll_id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	OptionID  uuid.UUID `db:"option_id" json:"option_id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
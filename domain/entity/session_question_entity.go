@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionQuestion struct {
+	ID           uuid.UUID    `db:"id" json:"id"`
+	SessionID    uuid.UUID    `db:"session_id" json:"session_id"`
+	UserID       uuid.UUID    `db:"user_id" json:"user_id"`
+	Content      string       `db:"content" json:"content"`
+	Status       int16        `db:"status" json:"status"`
+	AnsweredAt   sql.NullTime `db:"answered_at" json:"answered_at"`
+	HiddenAt     sql.NullTime `db:"hidden_at" json:"hidden_at"`
+	CreatedAt    time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time    `db:"updated_at" json:"updated_at"`
+	User         User         `db:"user" json:"user"`
+	CountUpvotes int64        `db:"count_upvotes" json:"count_upvotes"`
+	Upvoted      bool         `db:"upvoted" json:"upvoted"`
+}
+
+type SessionQuestionVote struct {
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+}
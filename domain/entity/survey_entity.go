@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SurveyTemplate struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	Title       string           `db:"title" json:"title"`
+	Description sql.NullString   `db:"description" json:"description"`
+	EventID     uuid.NullUUID    `db:"event_id" json:"event_id"`
+	SessionType sql.NullInt16    `db:"session_type" json:"session_type"`
+	CreatedBy   uuid.NullUUID    `db:"created_by" json:"created_by"`
+	CreatedAt   time.Time        `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time        `db:"updated_at" json:"updated_at"`
+	Questions   []SurveyQuestion `db:"-" json:"questions"`
+}
+
+type SurveyQuestion struct {
+	ID         uuid.UUID              `db:"id" json:"id"`
+	TemplateID uuid.UUID              `db:"template_id" json:"template_id"`
+	Position   int                    `db:"position" json:"position"`
+	Type       int16                  `db:"type" json:"type"`
+	Prompt     string                 `db:"prompt" json:"prompt"`
+	Required   bool                   `db:"required" json:"required"`
+	ScaleMin   sql.NullInt32          `db:"scale_min" json:"scale_min"`
+	ScaleMax   sql.NullInt32          `db:"scale_max" json:"scale_max"`
+	Options    []SurveyQuestionOption `db:"-" json:"options"`
+}
+
+type SurveyQuestionOption struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	Position   int       `db:"position" json:"position"`
+	Label      string    `db:"label" json:"label"`
+}
+
+type SurveyResponse struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	TemplateID uuid.UUID      `db:"template_id" json:"template_id"`
+	SessionID  uuid.UUID      `db:"session_id" json:"session_id"`
+	UserID     uuid.UUID      `db:"user_id" json:"user_id"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+	User       User           `db:"user" json:"user"`
+	Answers    []SurveyAnswer `db:"-" json:"answers"`
+}
+
+type SurveyAnswer struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	ResponseID uuid.UUID      `db:"response_id" json:"response_id"`
+	QuestionID uuid.UUID      `db:"question_id" json:"question_id"`
+	OptionID   uuid.NullUUID  `db:"option_id" json:"option_id"`
+	ScaleValue sql.NullInt32  `db:"scale_value" json:"scale_value"`
+	TextValue  sql.NullString `db:"text_value" json:"text_value"`
+}
+
+type SurveyOptionCount struct {
+	QuestionID uuid.UUID `db:"question_id" json:"question_id"`
+	OptionID   uuid.UUID `db:"option_id" json:"option_id"`
+	Count      int64     `db:"count" json:"count"`
+}
+
+type SurveyScaleSummary struct {
+	QuestionID   uuid.UUID `db:"question_id" json:"question_id"`
+	CountAnswers int64     `db:"count_answers" json:"count_answers"`
+	Average      float64   `db:"average" json:"average"`
+}
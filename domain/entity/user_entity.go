@@ -2,17 +2,53 @@ package entity
 
 import (
 	"database/sql"
+	"fmt"
 
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
 	"github.com/google/uuid"
 )
 
 type User struct {
-	ID        uuid.UUID      `db:"id" json:"id"`
-	Name      string         `db:"name" json:"name"`
-	Email     string         `db:"email" json:"email"`
-	Password  string         `db:"password" json:"-"`
-	Role      int16          `db:"role" json:"role"`
-	ImageURI  sql.NullString `db:"image_uri" json:"image_uri"`
-	CreatedAt string         `db:"created_at" json:"created_at"`
-	UpdatedAt string         `db:"updated_at" json:"updated_at"`
+	ID                    uuid.UUID      `db:"id" json:"id"`
+	Name                  string         `db:"name" json:"name"`
+	Email                 string         `db:"email" json:"email"`
+	Password              string         `db:"password" json:"-"`
+	Role                  int16          `db:"role" json:"role"`
+	ImageURI              sql.NullString `db:"image_uri" json:"image_uri"`
+	Interests             int16          `db:"interests" json:"interests"`
+	TimeZone              sql.NullString `db:"time_zone" json:"time_zone"`
+	Bio                   sql.NullString `db:"bio" json:"bio"`
+	Affiliation           sql.NullString `db:"affiliation" json:"affiliation"`
+	JobTitle              sql.NullString `db:"job_title" json:"job_title"`
+	EmailVisibility       int16          `db:"email_visibility" json:"email_visibility"`
+	BioVisibility         int16          `db:"bio_visibility" json:"bio_visibility"`
+	AffiliationVisibility int16          `db:"affiliation_visibility" json:"affiliation_visibility"`
+	JobTitleVisibility    int16          `db:"job_title_visibility" json:"job_title_visibility"`
+	LinksVisibility       int16          `db:"links_visibility" json:"links_visibility"`
+	CreatedAt             string         `db:"created_at" json:"created_at"`
+	UpdatedAt             string         `db:"updated_at" json:"updated_at"`
+	DeletedAt             sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	AnonymizedAt          sql.NullTime   `db:"anonymized_at" json:"anonymized_at"`
+}
+
+type UserLink struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+	Position int       `db:"position" json:"position"`
+	Label    string    `db:"label" json:"label"`
+	URL      string    `db:"url" json:"url"`
+}
+
+func (u *User) InterestsArray() []string {
+	// interests share the bit layout of session tags
+	binary := fmt.Sprintf("%06b", u.Interests)
+
+	interests := []string{}
+	for i, interest := range binary {
+		if interest == '1' {
+			interests = append(interests, enums.ShortSessionTag[i])
+		}
+	}
+
+	return interests
 }
@@ -0,0 +1,9 @@
+package enums
+
+var NotificationType = map[int16]string{
+	1: "session cancelled",
+	2: "session restored",
+	3: "registration released",
+	4: "registration approved",
+	5: "registration declined",
+}
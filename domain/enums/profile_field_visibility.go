@@ -0,0 +1,6 @@
+package enums
+
+var ProfileFieldVisibility = map[int16]string{
+	1: "public",
+	2: "coordinators",
+}
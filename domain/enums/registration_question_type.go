@@ -0,0 +1,7 @@
+package enums
+
+var RegistrationQuestionType = map[int16]string{
+	1: "text",
+	2: "select",
+	3: "checkbox",
+}
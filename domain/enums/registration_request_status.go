@@ -0,0 +1,7 @@
+package enums
+
+var RegistrationRequestStatus = map[int16]string{
+	1: "pending",
+	2: "approved",
+	3: "declined",
+}
@@ -0,0 +1,7 @@
+package enums
+
+var ReviewModerationAction = map[int16]string{
+	1: "hide",
+	2: "restore",
+	3: "dismiss",
+}
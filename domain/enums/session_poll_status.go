@@ -0,0 +1,7 @@
+package enums
+
+var SessionPollStatus = map[int16]string{
+	1: "draft",
+	2: "open",
+	3: "closed",
+}
@@ -0,0 +1,7 @@
+package enums
+
+var SessionQuestionStatus = map[int16]string{
+	1: "open",
+	2: "answered",
+	3: "hidden",
+}
@@ -4,4 +4,5 @@ var SessionStatus = map[int16]string{
 	1: "pending",
 	2: "approved",
 	3: "rejected",
+	4: "cancelled",
 }
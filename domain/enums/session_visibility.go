@@ -0,0 +1,7 @@
+package enums
+
+var SessionVisibility = map[int16]string{
+	1: "public",
+	2: "unlisted",
+	3: "invite only",
+}
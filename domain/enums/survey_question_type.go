@@ -0,0 +1,8 @@
+package enums
+
+var SurveyQuestionType = map[int16]string{
+	1: "single-choice",
+	2: "multiple-choice",
+	3: "scale",
+	4: "text",
+}
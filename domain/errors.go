@@ -2,7 +2,9 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
 type SerializableError interface {
@@ -58,6 +60,11 @@ var ErrBearerTokenNotActive = &RequestError{
 	Err:        errors.New("bearer token not active"),
 }
 
+var ErrUserDeleted = &RequestError{
+	StatusCode: http.StatusUnauthorized,
+	Err:        errors.New("user has been deleted"),
+}
+
 var ErrCantAccessResource = &RequestError{
 	StatusCode: http.StatusForbidden,
 	Err:        errors.New("you don't have access to this resource"),
@@ -152,3 +159,300 @@ var ErrCantUpdateTitle = &RequestError{
 	StatusCode: http.StatusBadRequest,
 	Err:        errors.New("you can't update the title"),
 }
+
+var ErrSessionNotReviewed = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("session not reviewed"),
+}
+
+var ErrReviewEditWindowClosed = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("review can no longer be edited"),
+}
+
+var ErrReviewNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("review not found"),
+}
+
+var ErrReviewNotDeleted = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("review is not deleted"),
+}
+
+var ErrReviewAlreadyReported = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("review already reported"),
+}
+
+var ErrReviewNotReported = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("review has no open reports"),
+}
+
+var ErrCantReportOwnReview = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("you can't report your own review"),
+}
+
+var ErrEventNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("event not found"),
+}
+
+var ErrSurveyNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("survey not found"),
+}
+
+var ErrSurveyTargetRequired = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("survey must be attached to an event or a session type"),
+}
+
+var ErrInvalidSurveyQuestion = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("invalid survey question"),
+}
+
+var ErrSurveyNotApplicable = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("survey is not attached to this session"),
+}
+
+var ErrSurveyAlreadyAnswered = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("survey already answered"),
+}
+
+var ErrInvalidSurveyAnswer = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("invalid survey answer"),
+}
+
+var ErrSurveyQuestionUnanswered = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("required survey question unanswered"),
+}
+
+var ErrSessionNotRunning = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("session is not running"),
+}
+
+var ErrQuestionNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("question not found"),
+}
+
+var ErrQuestionNotOpen = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("question is no longer open"),
+}
+
+var ErrQuestionAlreadyUpvoted = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("question already upvoted"),
+}
+
+var ErrQuestionNotUpvoted = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("question not upvoted"),
+}
+
+var ErrPollNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("poll not found"),
+}
+
+var ErrPollAlreadyOpened = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("poll already opened"),
+}
+
+var ErrPollNotOpen = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("poll is not open"),
+}
+
+var ErrPollAlreadyVoted = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("poll already voted"),
+}
+
+var ErrInvalidPollOption = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("invalid poll option"),
+}
+
+var ErrSeatStreamTargetRequired = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("subscribe to at least one session or an event"),
+}
+
+var ErrSessionIsCancelled = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("session is cancelled"),
+}
+
+var ErrSessionNotCancelled = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("session is not cancelled"),
+}
+
+var ErrNotificationNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("notification not found"),
+}
+
+var ErrSessionCloneEventRequired = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("event is required to clone an accepted session"),
+}
+
+var ErrImportFileRequired = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("import file is required"),
+}
+
+var ErrUnsupportedImportFormat = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("import file must be a csv or xlsx file"),
+}
+
+var ErrInvalidImportFile = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("import file could not be read"),
+}
+
+var ErrImportFileEmpty = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("import file has no rows"),
+}
+
+var ErrInvalidImportMapping = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("import mapping does not match the file columns"),
+}
+
+var ErrInvalidExportColumns = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("export columns are not valid"),
+}
+
+var ErrInvalidCursor = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("cursor is not valid for this list"),
+}
+
+var ErrCursorSortNotSupported = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("cursor pagination is not supported for this sort"),
+}
+
+var ErrSessionInviteRequired = &RequestError{
+	StatusCode: http.StatusForbidden,
+	Err:        errors.New("session is invite only"),
+}
+
+var ErrInvalidInviteCode = &RequestError{
+	StatusCode: http.StatusForbidden,
+	Err:        errors.New("invite code is invalid, expired or used up"),
+}
+
+var ErrSessionInviteNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("session invite not found"),
+}
+
+var ErrSessionInviteAlreadyExists = &RequestError{
+	StatusCode: http.StatusConflict,
+	Err:        errors.New("session invite already exists"),
+}
+
+var ErrRegistrationPending = &RequestError{
+	StatusCode: http.StatusConflict,
+	Err:        errors.New("registration is waiting for approval"),
+}
+
+var ErrRegistrationDeclined = &RequestError{
+	StatusCode: http.StatusForbidden,
+	Err:        errors.New("registration was declined"),
+}
+
+var ErrRegistrationRequestNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("registration request not found"),
+}
+
+var ErrRegistrationRequestDecided = &RequestError{
+	StatusCode: http.StatusConflict,
+	Err:        errors.New("registration request has already been decided"),
+}
+
+var ErrInvalidRegistrationQuestion = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("invalid registration question"),
+}
+
+var ErrRegistrationQuestionsAnswered = &RequestError{
+	StatusCode: http.StatusConflict,
+	Err:        errors.New("registration questions can't be changed once answered"),
+}
+
+var ErrInvalidRegistrationAnswer = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("invalid registration answer"),
+}
+
+var ErrRegistrationQuestionUnanswered = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("required registration question unanswered"),
+}
+
+var ErrInvalidPrerequisite = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("prerequisite must be another session that starts earlier"),
+}
+
+var ErrPrerequisiteStartsLater = &RequestError{
+	StatusCode: http.StatusBadRequest,
+	Err:        errors.New("session must start before the sessions that require it"),
+}
+
+var ErrPrerequisiteNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("prerequisite session not found"),
+}
+
+// Names the prerequisite sessions the user has neither attended nor
+// registered for.
+func NewPrerequisitesNotMetError(titles []string) *RequestError {
+	quoted := make([]string, 0, len(titles))
+	for _, title := range titles {
+		quoted = append(quoted, fmt.Sprintf("%q", title))
+	}
+
+	return &RequestError{
+		StatusCode: http.StatusForbidden,
+		Err:        fmt.Errorf("attend or register for %s first", strings.Join(quoted, ", ")),
+	}
+}
+
+// Tells the user which of the event's registration quotas they have used up.
+func NewRegistrationQuotaExceededError(limit int, scope string) *RequestError {
+	return &RequestError{
+		StatusCode: http.StatusForbidden,
+		Err:        fmt.Errorf("registration quota reached: at most %d registrations %s", limit, scope),
+	}
+}
+
+var ErrPublicRateLimited = &RequestError{
+	StatusCode: http.StatusTooManyRequests,
+	Err:        errors.New("too many requests, try again later"),
+}
+
+var ErrSpeakerNotFound = &RequestError{
+	StatusCode: http.StatusNotFound,
+	Err:        errors.New("speaker not found"),
+}
@@ -15,7 +15,7 @@ type authRepository struct {
 
 func (a *authRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	err := a.db.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1", email)
+	err := a.db.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1 AND deleted_at IS NULL", email)
 	if err != nil {
 		return nil, err
 	}
@@ -25,7 +25,7 @@ func (a *authRepository) FindByEmail(ctx context.Context, email string) (*entity
 
 func (a *authRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
-	err := a.db.GetContext(ctx, &user, "SELECT * FROM users WHERE id = $1", id)
+	err := a.db.GetContext(ctx, &user, "SELECT * FROM users WHERE id = $1 AND deleted_at IS NULL", id)
 	if err != nil {
 		return nil, err
 	}
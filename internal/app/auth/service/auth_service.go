@@ -34,10 +34,12 @@ func (a *authService) GetSession(ctx context.Context, query dto.GetSessionQuery)
 	}
 
 	userResponse := dto.UserResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Role:  user.Role,
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      user.Role,
+		Interests: user.InterestsArray(),
+		TimeZone:  user.TimeZone.String,
 	}
 
 	if user.ImageURI.Valid {
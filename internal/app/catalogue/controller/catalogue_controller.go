@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type catalogueController struct {
+	service contracts.CatalogueService
+}
+
+// Read-only routes for the landing page. They need no login, so they are
+// rate limited on their own and cached by clients.
+func InitCatalogueController(router fiber.Router, service contracts.CatalogueService) {
+	controller := catalogueController{
+		service: service,
+	}
+
+	publicRouter := router.Group("/public", middlewares.PublicRateLimit(), middlewares.PublicCache())
+
+	publicRouter.Get("/sessions", controller.GetSessions)
+	publicRouter.Get("/sessions/:id", controller.GetSession)
+	publicRouter.Get("/speakers", controller.GetSpeakers)
+	publicRouter.Get("/speakers/:id", controller.GetSpeaker)
+	publicRouter.Get("/rooms", controller.GetRooms)
+}
+
+func (c *catalogueController) GetSessions(ctx *fiber.Ctx) error {
+	var query dto.GetPublicSessionsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetSessions(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *catalogueController) GetSession(ctx *fiber.Ctx) error {
+	var query dto.GetPublicSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetSession(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *catalogueController) GetSpeakers(ctx *fiber.Ctx) error {
+	var query dto.GetPublicSpeakersQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetSpeakers(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *catalogueController) GetSpeaker(ctx *fiber.Ctx) error {
+	var query dto.GetPublicSpeakerQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetSpeaker(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *catalogueController) GetRooms(ctx *fiber.Ctx) error {
+	var query dto.GetPublicRoomsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetRooms(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type catalogueRepository struct {
+	db *sqlx.DB
+}
+
+// Sessions anyone may see: approved, public and not deleted. Cancelling a
+// session moves it out of the approved status, so cancelled ones are left out
+// too, as are sessions of deleted speakers.
+const publicSessionClause = `sessions.status = 2 AND sessions.visibility = 1 AND sessions.deleted_at IS NULL
+	AND proposer.deleted_at IS NULL`
+
+// Only the speaker fields a session listing needs. Which of them are public is
+// decided by the service.
+const publicSessionColumns = `sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
+	proposer.image_uri as "proposer.image_uri", proposer.affiliation as "proposer.affiliation",
+	proposer.job_title as "proposer.job_title",
+	proposer.affiliation_visibility as "proposer.affiliation_visibility",
+	proposer.job_title_visibility as "proposer.job_title_visibility"`
+
+func publicSessionFilter(
+	eventID uuid.UUID,
+	speakerID uuid.UUID,
+	tags int16,
+	beforeAt time.Time,
+	afterAt time.Time,
+) (string, []interface{}) {
+	where := " WHERE " + publicSessionClause
+	args := []interface{}{}
+
+	if eventID != uuid.Nil {
+		where += fmt.Sprintf(" AND sessions.event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	if speakerID != uuid.Nil {
+		where += fmt.Sprintf(" AND sessions.proposer_id = $%d", len(args)+1)
+		args = append(args, speakerID)
+	}
+
+	if tags != 0 {
+		where += fmt.Sprintf(" AND (sessions.tags & $%d) = $%d", len(args)+1, len(args)+1)
+		args = append(args, tags)
+	}
+
+	if !beforeAt.IsZero() {
+		where += fmt.Sprintf(" AND sessions.start_at < $%d", len(args)+1)
+		args = append(args, beforeAt)
+	}
+
+	if !afterAt.IsZero() {
+		where += fmt.Sprintf(" AND sessions.end_at > $%d", len(args)+1)
+		args = append(args, afterAt)
+	}
+
+	return where, args
+}
+
+func (c *catalogueRepository) FindSessions(
+	ctx context.Context,
+	limit, offset int,
+	eventID, speakerID uuid.UUID,
+	tags int16,
+	beforeAt, afterAt time.Time,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	where, args := publicSessionFilter(eventID, speakerID, tags, beforeAt, afterAt)
+	query := "SELECT " + publicSessionColumns + " FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id" +
+		where +
+		fmt.Sprintf(" ORDER BY sessions.start_at ASC, sessions.id ASC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	err := c.db.SelectContext(ctx, &sessions, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][FindSessions]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (c *catalogueRepository) CountSessions(
+	ctx context.Context,
+	eventID, speakerID uuid.UUID,
+	tags int16,
+	beforeAt, afterAt time.Time,
+) (int64, error) {
+	var count int64
+
+	where, args := publicSessionFilter(eventID, speakerID, tags, beforeAt, afterAt)
+	query := "SELECT COUNT(*) FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id" + where
+
+	err := c.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][CountSessions]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (c *catalogueRepository) FindSessionByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	var session entity.Session
+
+	query := "SELECT " + publicSessionColumns + " FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id" +
+		" WHERE sessions.id = $1 AND " + publicSessionClause
+
+	err := c.db.GetContext(ctx, &session, query, id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][FindSessionByID]")
+
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// Speakers are the users with at least one public session, in the event when
+// one is given.
+func publicSpeakerFilter(eventID uuid.UUID) (string, []interface{}) {
+	where := ` WHERE users.deleted_at IS NULL AND EXISTS (
+		SELECT 1 FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id
+		WHERE sessions.proposer_id=users.id AND ` + publicSessionClause
+	args := []interface{}{}
+
+	if eventID != uuid.Nil {
+		where += fmt.Sprintf(" AND sessions.event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	return where + ")", args
+}
+
+func (c *catalogueRepository) FindSpeakers(
+	ctx context.Context,
+	limit, offset int,
+	eventID uuid.UUID,
+) ([]entity.User, error) {
+	users := []entity.User{}
+
+	where, args := publicSpeakerFilter(eventID)
+	query := "SELECT users.* FROM users" + where +
+		fmt.Sprintf(" ORDER BY users.name ASC, users.id ASC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	err := c.db.SelectContext(ctx, &users, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][FindSpeakers]")
+
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (c *catalogueRepository) CountSpeakers(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	var count int64
+
+	where, args := publicSpeakerFilter(eventID)
+
+	err := c.db.GetContext(ctx, &count, "SELECT COUNT(*) FROM users"+where, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][CountSpeakers]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (c *catalogueRepository) FindSpeakerByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	var user entity.User
+
+	where, args := publicSpeakerFilter(uuid.Nil)
+	query := "SELECT users.* FROM users" + where + fmt.Sprintf(" AND users.id = $%d", len(args)+1)
+	args = append(args, id)
+
+	err := c.db.GetContext(ctx, &user, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][FindSpeakerByID]")
+
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *catalogueRepository) FindRooms(ctx context.Context, eventID uuid.UUID) ([]entity.Room, error) {
+	rooms := []entity.Room{}
+
+	where, args := publicSessionFilter(eventID, uuid.Nil, 0, time.Time{}, time.Time{})
+	query := `SELECT sessions.room AS name, COUNT(*) AS count_sessions
+		FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id` +
+		where + " AND COALESCE(sessions.room, '') <> ''" +
+		" GROUP BY sessions.room ORDER BY sessions.room ASC"
+
+	err := c.db.SelectContext(ctx, &rooms, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[CatalogueRepository][FindRooms]")
+
+		return nil, err
+	}
+
+	return rooms, nil
+}
+
+func NewCatalogueRepository(db *sqlx.DB) contracts.CatalogueRepository {
+	return &catalogueRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+)
+
+type catalogueService struct {
+	repo      contracts.CatalogueRepository
+	userRepo  contracts.UserRepository
+	validator validator.ValidatorInterface
+}
+
+func (c *catalogueService) GetSessions(
+	ctx context.Context,
+	query dto.GetPublicSessionsQuery,
+) (dto.GetPublicSessionsResponse, error) {
+	valErr := c.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetPublicSessionsResponse{}, valErr
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	var tags int16
+	for i, tag := range enums.ShortSessionTag {
+		if slices.Contains(query.Tags, tag) {
+			tags |= 1 << (len(enums.ShortSessionTag) - 1 - i)
+		}
+	}
+
+	sessions, err := c.repo.FindSessions(
+		ctx,
+		query.Limit,
+		(query.Page-1)*query.Limit,
+		query.EventID,
+		query.SpeakerID,
+		tags,
+		query.BeforeAt,
+		query.AfterAt,
+	)
+	if err != nil {
+		return dto.GetPublicSessionsResponse{}, err
+	}
+
+	totalData, err := c.repo.CountSessions(ctx, query.EventID, query.SpeakerID, tags, query.BeforeAt, query.AfterAt)
+	if err != nil {
+		return dto.GetPublicSessionsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	res := dto.GetPublicSessionsResponse{
+		Sessions: make([]dto.PublicSessionResponse, 0, len(sessions)),
+		Meta: dto.PaginationResponse{
+			TotalData: &totalData,
+			TotalPage: &totalPage,
+			Page:      query.Page,
+			Limit:     query.Limit,
+		},
+	}
+
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, toPublicSessionResponse(session))
+	}
+
+	return res, nil
+}
+
+func (c *catalogueService) GetSession(
+	ctx context.Context,
+	query dto.GetPublicSessionQuery,
+) (dto.GetPublicSessionResponse, error) {
+	valErr := c.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetPublicSessionResponse{}, valErr
+	}
+
+	// sessions outside the catalogue are reported as missing rather than forbidden
+	session, err := c.repo.FindSessionByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetPublicSessionResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.GetPublicSessionResponse{}, err
+	}
+
+	res := dto.GetPublicSessionResponse{
+		Session: toPublicSessionResponse(*session),
+	}
+
+	return res, nil
+}
+
+func (c *catalogueService) GetSpeakers(
+	ctx context.Context,
+	query dto.GetPublicSpeakersQuery,
+) (dto.GetPublicSpeakersResponse, error) {
+	valErr := c.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetPublicSpeakersResponse{}, valErr
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	speakers, err := c.repo.FindSpeakers(ctx, query.Limit, (query.Page-1)*query.Limit, query.EventID)
+	if err != nil {
+		return dto.GetPublicSpeakersResponse{}, err
+	}
+
+	totalData, err := c.repo.CountSpeakers(ctx, query.EventID)
+	if err != nil {
+		return dto.GetPublicSpeakersResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	res := dto.GetPublicSpeakersResponse{
+		Speakers: make([]dto.PublicSpeakerResponse, 0, len(speakers)),
+		Meta: dto.PaginationResponse{
+			TotalData: &totalData,
+			TotalPage: &totalPage,
+			Page:      query.Page,
+			Limit:     query.Limit,
+		},
+	}
+
+	// links are left to the speaker page
+	for _, speaker := range speakers {
+		res.Speakers = append(res.Speakers, toPublicSpeakerResponse(speaker))
+	}
+
+	return res, nil
+}
+
+func (c *catalogueService) GetSpeaker(
+	ctx context.Context,
+	query dto.GetPublicSpeakerQuery,
+) (dto.GetPublicSpeakerResponse, error) {
+	valErr := c.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetPublicSpeakerResponse{}, valErr
+	}
+
+	speaker, err := c.repo.FindSpeakerByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetPublicSpeakerResponse{}, domain.ErrSpeakerNotFound
+		}
+
+		return dto.GetPublicSpeakerResponse{}, err
+	}
+
+	speakerResponse := toPublicSpeakerResponse(*speaker)
+
+	if speaker.LinksVisibility == 1 { // public
+		links, err := c.userRepo.FindLinks(ctx, speaker.ID)
+		if err != nil {
+			return dto.GetPublicSpeakerResponse{}, err
+		}
+
+		for _, link := range links {
+			speakerResponse.Links = append(speakerResponse.Links, dto.UserLinkResponse{
+				Label: link.Label,
+				URL:   link.URL,
+			})
+		}
+	}
+
+	res := dto.GetPublicSpeakerResponse{
+		Speaker: speakerResponse,
+	}
+
+	return res, nil
+}
+
+func (c *catalogueService) GetRooms(
+	ctx context.Context,
+	query dto.GetPublicRoomsQuery,
+) (dto.GetPublicRoomsResponse, error) {
+	valErr := c.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetPublicRoomsResponse{}, valErr
+	}
+
+	rooms, err := c.repo.FindRooms(ctx, query.EventID)
+	if err != nil {
+		return dto.GetPublicRoomsResponse{}, err
+	}
+
+	res := dto.GetPublicRoomsResponse{
+		Rooms: make([]dto.PublicRoomResponse, 0, len(rooms)),
+	}
+
+	for _, room := range rooms {
+		res.Rooms = append(res.Rooms, dto.PublicRoomResponse{
+			Name:          room.Name,
+			CountSessions: room.CountSessions,
+		})
+	}
+
+	return res, nil
+}
+
+func toPublicSessionResponse(session entity.Session) dto.PublicSessionResponse {
+	return dto.PublicSessionResponse{
+		ID:               session.ID,
+		Title:            session.Title,
+		Description:      session.Description.String,
+		Type:             session.Type,
+		Tags:             session.TagsArray(),
+		StartAt:          session.StartAt.UTC(),
+		EndAt:            session.EndAt.UTC(),
+		TimeZone:         session.TimeZone,
+		StartAtLocal:     timezone.WallTime(session.StartAt, session.TimeZone),
+		EndAtLocal:       timezone.WallTime(session.EndAt, session.TimeZone),
+		Room:             session.Room.String,
+		Capacity:         session.Capacity,
+		RequiresApproval: session.RequiresApproval,
+		ImageURI:         session.ImageURI.String,
+		EventID:          session.EventID,
+		Speaker:          toPublicSpeakerResponse(session.Proposer),
+	}
+}
+
+// Keeps only the profile fields the speaker made public. Emails are never
+// part of the catalogue.
+func toPublicSpeakerResponse(user entity.User) dto.PublicSpeakerResponse {
+	speakerResponse := dto.PublicSpeakerResponse{
+		ID:   user.ID,
+		Name: user.Name,
+	}
+
+	if user.ImageURI.Valid {
+		speakerResponse.ImageURI = &user.ImageURI.String
+	}
+
+	if user.BioVisibility == 1 {
+		speakerResponse.Bio = user.Bio.String
+	}
+
+	if user.AffiliationVisibility == 1 {
+		speakerResponse.Affiliation = user.Affiliation.String
+	}
+
+	if user.JobTitleVisibility == 1 {
+		speakerResponse.JobTitle = user.JobTitle.String
+	}
+
+	return speakerResponse
+}
+
+func NewCatalogueService(
+	repo contracts.CatalogueRepository,
+	userRepo contracts.UserRepository,
+	validator validator.ValidatorInterface,
+) contracts.CatalogueService {
+	return &catalogueService{
+		repo:      repo,
+		userRepo:  userRepo,
+		validator: validator,
+	}
+}
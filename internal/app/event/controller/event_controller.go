@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/gofiber/fiber/v2"
+)
+
+type eventController struct {
+	service contracts.EventService
+}
+
+func InitEventController(router fiber.Router, service contracts.EventService, middleware *middlewares.Middleware) {
+	controller := eventController{
+		service: service,
+	}
+
+	eventRouter := router.Group("/events")
+
+	eventRouter.Get("/", middleware.RequireAuth(), controller.GetEvents)
+	eventRouter.Get("/:id", middleware.RequireAuth(), controller.GetEvent)
+	eventRouter.Post(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.CreateEvent,
+	)
+	eventRouter.Patch(
+		"/:id",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.UpdateEvent,
+	)
+	eventRouter.Delete(
+		"/:id",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.DeleteEvent,
+	)
+}
+
+func (e *eventController) GetEvents(ctx *fiber.Ctx) error {
+	var query dto.GetEventsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := e.service.GetEvents(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (e *eventController) GetEvent(ctx *fiber.Ctx) error {
+	var query dto.GetEventQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := e.service.GetEvent(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (e *eventController) CreateEvent(ctx *fiber.Ctx) error {
+	var req dto.CreateEventRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err := e.service.CreateEvent(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (e *eventController) UpdateEvent(ctx *fiber.Ctx) error {
+	var req dto.UpdateEventRequest
+	if err := ctx.ParamsParser(&req); err != nil {
+		return err
+	}
+
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err := e.service.UpdateEvent(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (e *eventController) DeleteEvent(ctx *fiber.Ctx) error {
+	var query dto.DeleteEventQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := e.service.DeleteEvent(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
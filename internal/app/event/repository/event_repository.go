@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type eventRepository struct {
+	db *sqlx.DB
+}
+
+func (e *eventRepository) Create(ctx context.Context, event *entity.Event) error {
+	_, err := e.db.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO events
+		(id, name, description, start_at, end_at, time_zone, max_registrations, max_registrations_per_tag,
+			max_registrations_per_day)
+		VALUES (:id, :name, :description, :start_at, :end_at, :time_zone, :max_registrations,
+			:max_registrations_per_tag, :max_registrations_per_day)
+		`,
+		event,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][Create]")
+
+		return err
+	}
+
+	return nil
+}
+
+// Sessions of the event are moved to its time zone in the same statement.
+func (e *eventRepository) Update(ctx context.Context, event *entity.Event) error {
+	_, err := e.db.NamedExecContext(
+		ctx,
+		`
+		WITH updated AS (
+			UPDATE events
+			SET name = :name, description = :description, start_at = :start_at, end_at = :end_at,
+				time_zone = :time_zone, max_registrations = :max_registrations,
+				max_registrations_per_tag = :max_registrations_per_tag,
+				max_registrations_per_day = :max_registrations_per_day
+			WHERE id = :id
+			RETURNING id, time_zone
+		)
+		UPDATE sessions SET time_zone = updated.time_zone
+		FROM updated
+		WHERE sessions.event_id = updated.id
+		`,
+		event,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][Update]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := e.db.ExecContext(ctx, "DELETE FROM events WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][Delete]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventRepository) FindAll(
+	ctx context.Context,
+	limit int,
+	offset int,
+	sortBy string,
+	sortOrder string,
+	search string,
+) ([]entity.Event, error) {
+	events := []entity.Event{}
+	query := "SELECT * FROM events WHERE 1=1"
+	args := []interface{}{}
+
+	if search != "" {
+		query += fmt.Sprintf(" AND (name ILIKE $%d)", len(args)+1)
+		args = append(args, "%"+search+"%")
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s %s LIMIT $%d OFFSET $%d", sortBy, sortOrder, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	err := e.db.SelectContext(ctx, &events, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][FindAll]")
+
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (e *eventRepository) Count(ctx context.Context, search string) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM events WHERE 1=1"
+	args := []interface{}{}
+
+	if search != "" {
+		query += fmt.Sprintf(" AND (name ILIKE $%d)", len(args)+1)
+		args = append(args, "%"+search+"%")
+	}
+
+	err := e.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][Count]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (e *eventRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	var event entity.Event
+	err := e.db.GetContext(ctx, &event, "SELECT * FROM events WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[EventRepository][FindByID]")
+
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func NewEventRepository(db *sqlx.DB) contracts.EventRepository {
+	return &eventRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+)
+
+type eventService struct {
+	repo      contracts.EventRepository
+	validator validator.ValidatorInterface
+	uuid      uuid.CustomUUIDInterface
+}
+
+func (e *eventService) GetEvents(ctx context.Context, query dto.GetEventsQuery) (dto.GetEventsResponse, error) {
+	valErr := e.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetEventsResponse{}, valErr
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	if query.SortBy == "" {
+		query.SortBy = "start_at"
+	}
+
+	if query.SortOrder == "" {
+		query.SortOrder = "asc"
+	}
+
+	events, err := e.repo.FindAll(
+		ctx,
+		query.Limit,
+		(query.Page-1)*query.Limit,
+		query.SortBy,
+		query.SortOrder,
+		query.Search,
+	)
+	if err != nil {
+		return dto.GetEventsResponse{}, err
+	}
+
+	totalData, err := e.repo.Count(ctx, query.Search)
+	if err != nil {
+		return dto.GetEventsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	meta := dto.PaginationResponse{
+		TotalData: &totalData,
+		TotalPage: &totalPage,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	res := dto.GetEventsResponse{
+		Events: make([]dto.EventResponse, 0, len(events)),
+		Meta:   meta,
+	}
+
+	for _, event := range events {
+		res.Events = append(res.Events, dto.EventResponse{
+			ID:                     event.ID,
+			Name:                   event.Name,
+			Description:            event.Description.String,
+			StartAt:                event.StartAt.UTC(),
+			EndAt:                  event.EndAt.UTC(),
+			TimeZone:               event.TimeZone,
+			StartAtLocal:           timezone.WallTime(event.StartAt, event.TimeZone),
+			EndAtLocal:             timezone.WallTime(event.EndAt, event.TimeZone),
+			MaxRegistrations:       quotaLimit(event.MaxRegistrations),
+			MaxRegistrationsPerTag: quotaLimit(event.MaxRegistrationsPerTag),
+			MaxRegistrationsPerDay: quotaLimit(event.MaxRegistrationsPerDay),
+		})
+	}
+
+	return res, nil
+}
+
+func (e *eventService) GetEvent(ctx context.Context, query dto.GetEventQuery) (dto.GetEventResponse, error) {
+	valErr := e.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetEventResponse{}, valErr
+	}
+
+	event, err := e.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetEventResponse{}, domain.ErrEventNotFound
+		}
+
+		return dto.GetEventResponse{}, err
+	}
+
+	res := dto.GetEventResponse{
+		Event: dto.EventResponse{
+			ID:                     event.ID,
+			Name:                   event.Name,
+			Description:            event.Description.String,
+			StartAt:                event.StartAt.UTC(),
+			EndAt:                  event.EndAt.UTC(),
+			TimeZone:               event.TimeZone,
+			StartAtLocal:           timezone.WallTime(event.StartAt, event.TimeZone),
+			EndAtLocal:             timezone.WallTime(event.EndAt, event.TimeZone),
+			MaxRegistrations:       quotaLimit(event.MaxRegistrations),
+			MaxRegistrationsPerTag: quotaLimit(event.MaxRegistrationsPerTag),
+			MaxRegistrationsPerDay: quotaLimit(event.MaxRegistrationsPerDay),
+		},
+	}
+
+	return res, nil
+}
+
+func (e *eventService) CreateEvent(ctx context.Context, req dto.CreateEventRequest) error {
+	valErr := e.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	id, err := e.uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	event := &entity.Event{
+		ID:               id,
+		Name:             req.Name,
+		Description:      sql.NullString{String: req.Description, Valid: req.Description != ""},
+		StartAt:          req.StartAt,
+		EndAt:            req.EndAt,
+		TimeZone:         cmp.Or(req.TimeZone, timezone.Default.String()),
+		MaxRegistrations: sql.NullInt32{Int32: int32(req.MaxRegistrations), Valid: req.MaxRegistrations != 0},
+		MaxRegistrationsPerTag: sql.NullInt32{
+			Int32: int32(req.MaxRegistrationsPerTag),
+			Valid: req.MaxRegistrationsPerTag != 0,
+		},
+		MaxRegistrationsPerDay: sql.NullInt32{
+			Int32: int32(req.MaxRegistrationsPerDay),
+			Valid: req.MaxRegistrationsPerDay != 0,
+		},
+	}
+
+	err = e.repo.Create(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventService) UpdateEvent(ctx context.Context, req dto.UpdateEventRequest) error {
+	valErr := e.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	event, err := e.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+
+		return err
+	}
+
+	if req.Name != "" {
+		event.Name = req.Name
+	}
+
+	if req.Description != "" {
+		event.Description = sql.NullString{String: req.Description, Valid: true}
+	}
+
+	if !req.StartAt.IsZero() {
+		event.StartAt = req.StartAt
+	}
+
+	if !req.EndAt.IsZero() {
+		event.EndAt = req.EndAt
+	}
+
+	if req.TimeZone != "" {
+		event.TimeZone = req.TimeZone
+	}
+
+	if req.MaxRegistrations != nil {
+		event.MaxRegistrations = sql.NullInt32{Int32: int32(*req.MaxRegistrations), Valid: *req.MaxRegistrations != 0}
+	}
+
+	if req.MaxRegistrationsPerTag != nil {
+		event.MaxRegistrationsPerTag = sql.NullInt32{
+			Int32: int32(*req.MaxRegistrationsPerTag),
+			Valid: *req.MaxRegistrationsPerTag != 0,
+		}
+	}
+
+	if req.MaxRegistrationsPerDay != nil {
+		event.MaxRegistrationsPerDay = sql.NullInt32{
+			Int32: int32(*req.MaxRegistrationsPerDay),
+			Valid: *req.MaxRegistrationsPerDay != 0,
+		}
+	}
+
+	err = e.repo.Update(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *eventService) DeleteEvent(ctx context.Context, query dto.DeleteEventQuery) error {
+	valErr := e.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	_, err := e.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+
+		return err
+	}
+
+	err = e.repo.Delete(ctx, query.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// A missing limit is left out of the response rather than shown as zero.
+func quotaLimit(limit sql.NullInt32) *int {
+	if !limit.Valid {
+		return nil
+	}
+
+	value := int(limit.Int32)
+
+	return &value
+}
+
+func NewEventService(
+	repo contracts.EventRepository,
+	validator validator.ValidatorInterface,
+	uuid uuid.CustomUUIDInterface,
+) contracts.EventService {
+	return &eventService{
+		repo:      repo,
+		validator: validator,
+		uuid:      uuid,
+	}
+}
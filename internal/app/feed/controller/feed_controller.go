@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/gofiber/fiber/v2"
+)
+
+type feedController struct {
+	service contracts.FeedService
+}
+
+// Feeds are read by bots and feed readers without a login, so they share the
+// public rate limit and caching of the catalogue.
+func InitFeedController(router fiber.Router, service contracts.FeedService) {
+	controller := feedController{
+		service: service,
+	}
+
+	feedRouter := router.Group("/feeds", middlewares.PublicRateLimit(), middlewares.PublicCache())
+
+	feedRouter.Get("/sessions.:format", controller.GetSessionFeed)
+}
+
+func (f *feedController) GetSessionFeed(ctx *fiber.Ctx) error {
+	var query dto.GetSessionFeedQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	// the feed ends up in shared caches, so its links come from the configured
+	// URL rather than the client's Host header
+	query.BaseURL = strings.TrimSuffix(env.AppEnv.AppURL, "/")
+	query.FeedURL = query.BaseURL + ctx.OriginalURL()
+
+	res, err := f.service.GetSessionFeed(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, res.ContentType)
+
+	return ctx.Status(fiber.StatusOK).Send(res.Body)
+}
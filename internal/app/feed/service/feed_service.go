@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/feed"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+)
+
+type feedService struct {
+	sessionRepo contracts.SessionRepository
+	feed        feed.CustomFeedInterface
+	validator   validator.ValidatorInterface
+}
+
+func (f *feedService) GetSessionFeed(ctx context.Context, query dto.GetSessionFeedQuery) (dto.FeedResponse, error) {
+	valErr := f.validator.Validate(query)
+	if valErr != nil {
+		return dto.FeedResponse{}, valErr
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 50
+	}
+
+	var tags int16
+	for i, tag := range enums.ShortSessionTag {
+		if slices.Contains(query.Tags, tag) {
+			tags |= 1 << (len(enums.ShortSessionTag) - 1 - i)
+		}
+	}
+
+	sessions, err := f.sessionRepo.FindPublished(ctx, query.Limit, query.Type, tags)
+	if err != nil {
+		return dto.FeedResponse{}, err
+	}
+
+	// an empty feed keeps a fixed date so its ETag stays the same
+	channel := feed.Channel{
+		ID:          query.FeedURL,
+		Title:       "BCC Conference sessions",
+		Description: "Newly approved sessions, with cancellations and schedule changes",
+		Link:        query.BaseURL + "/api/v1/public/sessions",
+		SelfLink:    query.FeedURL,
+		Updated:     time.Unix(0, 0),
+	}
+
+	for _, session := range sessions {
+		item := toSessionFeedItem(session, query.BaseURL)
+		if item.Updated.After(channel.Updated) {
+			channel.Updated = item.Updated
+		}
+
+		channel.Items = append(channel.Items, item)
+	}
+
+	body, err := f.feed.Encode(query.Format, channel)
+	if err != nil {
+		return dto.FeedResponse{}, err
+	}
+
+	res := dto.FeedResponse{
+		ContentType: feed.ContentTypes[query.Format],
+		Body:        body,
+	}
+
+	return res, nil
+}
+
+// An item keeps its ID for the life of the session. A cancellation or a
+// reschedule updates it and says so in the title.
+func toSessionFeedItem(session entity.Session, baseURL string) feed.Item {
+	loc := timezone.Location(session.TimeZone)
+	schedule := fmt.Sprintf(
+		"%s to %s (%s)",
+		session.StartAt.In(loc).Format("Mon, 2 Jan 2006 15:04"),
+		session.EndAt.In(loc).Format("15:04"),
+		session.TimeZone,
+	)
+
+	if session.Room.Valid {
+		schedule += ", " + session.Room.String
+	} else {
+		schedule += ", online"
+	}
+
+	item := feed.Item{
+		ID:        "urn:uuid:" + session.ID.String(),
+		Title:     session.Title,
+		Link:      baseURL + "/api/v1/public/sessions/" + session.ID.String(),
+		Summary:   strings.TrimSpace(schedule + "\n\n" + session.Description.String),
+		Author:    session.Proposer.Name,
+		Published: session.ApprovedAt.Time,
+		Updated:   session.ApprovedAt.Time,
+	}
+
+	for _, tag := range session.TagsArray() {
+		for i, shortTag := range enums.ShortSessionTag {
+			if shortTag == tag {
+				item.Categories = append(item.Categories, enums.SessionTag[i])
+			}
+		}
+	}
+
+	if session.RescheduledAt.Valid && session.RescheduledAt.Time.After(item.Updated) {
+		item.Title = "Rescheduled: " + session.Title
+		item.Updated = session.RescheduledAt.Time
+	}
+
+	if session.Status == 4 && session.CancelledAt.Valid { // cancelled
+		item.Title = "Cancelled: " + session.Title
+		item.Summary = strings.TrimSpace(schedule + "\n\n" + session.CancelledReason.String)
+
+		if session.CancelledAt.Time.After(item.Updated) {
+			item.Updated = session.CancelledAt.Time
+		}
+	}
+
+	return item
+}
+
+func NewFeedService(
+	sessionRepo contracts.SessionRepository,
+	feed feed.CustomFeedInterface,
+	validator validator.ValidatorInterface,
+) contracts.FeedService {
+	return &feedService{
+		sessionRepo: sessionRepo,
+		feed:        feed,
+		validator:   validator,
+	}
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+type notificationController struct {
+	service contracts.NotificationService
+}
+
+func InitNotificationController(
+	router fiber.Router,
+	service contracts.NotificationService,
+	middleware *middlewares.Middleware,
+) {
+	controller := notificationController{
+		service: service,
+	}
+
+	notificationRouter := router.Group("/notifications")
+
+	notificationRouter.Get("/", middleware.RequireAuth(), controller.GetNotifications)
+	notificationRouter.Post("/:id/read", middleware.RequireAuth(), controller.ReadNotification)
+}
+
+func (n *notificationController) GetNotifications(ctx *fiber.Ctx) error {
+	var query dto.GetNotificationsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+
+	res, err := n.service.GetNotifications(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (n *notificationController) ReadNotification(ctx *fiber.Ctx) error {
+	var query dto.ReadNotificationQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+
+	err := n.service.ReadNotification(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/database"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type notificationRepository struct {
+	db *sqlx.DB
+}
+
+func (n *notificationRepository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, n.db)
+}
+
+func (n *notificationRepository) FindAll(
+	ctx context.Context,
+	userID uuid.UUID,
+	unread bool,
+	limit int,
+	offset int,
+) ([]entity.Notification, error) {
+	query := "SELECT * FROM notifications WHERE user_id = $1"
+
+	if unread {
+		query += " AND read_at IS NULL"
+	}
+
+	query += " ORDER BY created_at DESC LIMIT $2 OFFSET $3"
+
+	notifications := []entity.Notification{}
+	err := n.conn(ctx).SelectContext(ctx, &notifications, query, userID, limit, offset)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[NotificationRepository][FindAll]")
+
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (n *notificationRepository) Count(ctx context.Context, userID uuid.UUID, unread bool) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM notifications WHERE user_id = $1"
+
+	if unread {
+		query += " AND read_at IS NULL"
+	}
+
+	err := n.conn(ctx).GetContext(ctx, &count, query, userID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[NotificationRepository][Count]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (n *notificationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Notification, error) {
+	var notification entity.Notification
+	err := n.conn(ctx).GetContext(ctx, &notification, "SELECT * FROM notifications WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[NotificationRepository][FindByID]")
+
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (n *notificationRepository) CreateMany(ctx context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	_, err := n.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO notifications
+		(id, user_id, type, title, body, session_id)
+		VALUES (:id, :user_id, :type, :title, :body, :session_id)
+		`,
+		notifications,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[NotificationRepository][CreateMany]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (n *notificationRepository) MarkAsRead(ctx context.Context, id uuid.UUID) error {
+	_, err := n.conn(ctx).ExecContext(
+		ctx,
+		"UPDATE notifications SET read_at = CURRENT_TIMESTAMP WHERE id = $1 AND read_at IS NULL",
+		id,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[NotificationRepository][MarkAsRead]")
+
+		return err
+	}
+
+	return nil
+}
+
+func NewNotificationRepository(db *sqlx.DB) contracts.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
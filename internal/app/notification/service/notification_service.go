@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+)
+
+type notificationService struct {
+	repo      contracts.NotificationRepository
+	validator validator.ValidatorInterface
+}
+
+func (n *notificationService) GetNotifications(
+	ctx context.Context,
+	query dto.GetNotificationsQuery,
+) (dto.GetNotificationsResponse, error) {
+	valErr := n.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetNotificationsResponse{}, valErr
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	notifications, err := n.repo.FindAll(
+		ctx,
+		query.UserID,
+		query.Unread,
+		query.Limit,
+		query.Limit*(query.Page-1),
+	)
+	if err != nil {
+		return dto.GetNotificationsResponse{}, err
+	}
+
+	totalData, err := n.repo.Count(ctx, query.UserID, query.Unread)
+	if err != nil {
+		return dto.GetNotificationsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	meta := dto.PaginationResponse{
+		TotalData: &totalData,
+		TotalPage: &totalPage,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	notificationsResponse := []dto.NotificationResponse{}
+	for _, notification := range notifications {
+		notificationResponse := dto.NotificationResponse{
+			ID:        notification.ID,
+			Type:      notification.Type,
+			Title:     notification.Title,
+			Body:      notification.Body,
+			SessionID: notification.SessionID,
+			CreatedAt: notification.CreatedAt,
+		}
+
+		if notification.ReadAt.Valid {
+			notificationResponse.ReadAt = &notification.ReadAt.Time
+		}
+
+		notificationsResponse = append(notificationsResponse, notificationResponse)
+	}
+
+	res := dto.GetNotificationsResponse{
+		Notifications: notificationsResponse,
+		Meta:          meta,
+	}
+
+	return res, nil
+}
+
+func (n *notificationService) ReadNotification(ctx context.Context, query dto.ReadNotificationQuery) error {
+	valErr := n.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	notification, err := n.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrNotificationNotFound
+		}
+
+		return err
+	}
+
+	if notification.UserID != query.UserID {
+		return domain.ErrNotificationNotFound
+	}
+
+	err = n.repo.MarkAsRead(ctx, query.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewNotificationService(
+	repo contracts.NotificationRepository,
+	validator validator.ValidatorInterface,
+) contracts.NotificationService {
+	return &notificationService{
+		repo:      repo,
+		validator: validator,
+	}
+}
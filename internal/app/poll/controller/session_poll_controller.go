@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/stream"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+type sessionPollController struct {
+	service contracts.SessionPollService
+}
+
+func InitSessionPollController(
+	router fiber.Router,
+	service contracts.SessionPollService,
+	middleware *middlewares.Middleware,
+) {
+	controller := sessionPollController{
+		service: service,
+	}
+
+	pollRouter := router.Group("/sessions/:sessionID/polls")
+
+	pollRouter.Get(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // user, event coordinator, admin
+		controller.GetSessionPolls,
+	)
+	pollRouter.Get(
+		"/ws",
+		middleware.RequireStreamAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // user, event coordinator, admin
+		controller.SubscribeSessionPolls,
+		stream.WebSocket(),
+	)
+	pollRouter.Post(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // speaker
+		controller.CreateSessionPoll,
+	)
+	pollRouter.Post(
+		"/:pollID/open",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // speaker
+		controller.OpenSessionPoll,
+	)
+	pollRouter.Post(
+		"/:pollID/close",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // speaker
+		controller.CloseSessionPoll,
+	)
+	pollRouter.Post(
+		"/:pollID/votes",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.VoteSessionPoll,
+	)
+}
+
+func (s *sessionPollController) GetSessionPolls(ctx *fiber.Ctx) error {
+	var query dto.GetSessionPollsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	res, err := s.service.GetSessionPolls(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *sessionPollController) CreateSessionPoll(ctx *fiber.Ctx) error {
+	var query dto.CreateSessionPollQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.CreateSessionPollRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := s.service.CreateSessionPoll(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (s *sessionPollController) OpenSessionPoll(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionPollQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.OpenSessionPoll(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionPollController) CloseSessionPoll(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionPollQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.CloseSessionPoll(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionPollController) VoteSessionPoll(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionPollQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req dto.VoteSessionPollRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err = s.service.VoteSessionPoll(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (s *sessionPollController) SubscribeSessionPolls(ctx *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
+	}
+
+	var query dto.SubscribeSessionPollsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	messages, unsubscribe, err := s.service.SubscribeSessionPolls(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return stream.Subscribe(ctx, messages, unsubscribe)
+}
+
+func (s *sessionPollController) parseSessionPollQuery(ctx *fiber.Ctx) (dto.SessionPollQuery, error) {
+	var query dto.SessionPollQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return dto.SessionPollQuery{}, err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return dto.SessionPollQuery{}, domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	return query, nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type sessionPollRepository struct {
+	db *sqlx.DB
+}
+
+func (s *sessionPollRepository) FindAll(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	includeDrafts bool,
+) ([]entity.SessionPoll, error) {
+	query := "SELECT * FROM session_polls WHERE session_id = $1"
+
+	if !includeDrafts {
+		query += " AND status != 1" // draft
+	}
+
+	query += " ORDER BY created_at ASC"
+
+	polls := []entity.SessionPoll{}
+	err := s.db.SelectContext(ctx, &polls, query, sessionID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][FindAll]")
+
+		return nil, err
+	}
+
+	options, err := s.findOptions(ctx, "session_polls.session_id", sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range polls {
+		for _, option := range options {
+			if option.PollID == polls[i].ID {
+				polls[i].Options = append(polls[i].Options, option)
+			}
+		}
+	}
+
+	return polls, nil
+}
+
+func (s *sessionPollRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SessionPoll, error) {
+	var poll entity.SessionPoll
+	err := s.db.GetContext(ctx, &poll, "SELECT * FROM session_polls WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][FindByID]")
+
+		return nil, err
+	}
+
+	options, err := s.findOptions(ctx, "session_polls.id", id)
+	if err != nil {
+		return nil, err
+	}
+
+	poll.Options = options
+
+	return &poll, nil
+}
+
+func (s *sessionPollRepository) Create(ctx context.Context, poll *entity.SessionPoll) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][Create]")
+
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO session_polls
+		(id, session_id, question)
+		VALUES (:id, :session_id, :question)
+		`,
+		poll,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][Create]")
+
+		return err
+	}
+
+	for _, option := range poll.Options {
+		_, err = tx.NamedExecContext(
+			ctx,
+			`
+			INSERT INTO session_poll_options
+			(id, poll_id, position, label)
+			VALUES (:id, :poll_id, :position, :label)
+			`,
+			option,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionPollRepository][Create]")
+
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][Create]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionPollRepository) Update(ctx context.Context, poll *entity.SessionPoll) error {
+	_, err := s.db.NamedExecContext(
+		ctx,
+		`
+		UPDATE session_polls
+		SET status = :status, opened_at = :opened_at, closed_at = :closed_at
+		WHERE id = :id
+		`,
+		poll,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][Update]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionPollRepository) CountVotes(ctx context.Context, pollID uuid.UUID, userID uuid.UUID) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM session_poll_votes WHERE poll_id = $1"
+	args := []interface{}{pollID}
+
+	if userID != uuid.Nil {
+		query += fmt.Sprintf(" AND user_id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	err := s.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][CountVotes]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sessionPollRepository) CreateVote(ctx context.Context, vote *entity.SessionPollVote) error {
+	_, err := s.db.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO session_poll_votes
+		(poll_id, user_id, option_id)
+		VALUES (:poll_id, :user_id, :option_id)
+		`,
+		vote,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][CreateVote]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionPollRepository) findOptions(
+	ctx context.Context,
+	column string,
+	value uuid.UUID,
+) ([]entity.SessionPollOption, error) {
+	query := fmt.Sprintf(`SELECT session_poll_options.*,
+		(
+			SELECT COUNT(*) FROM session_poll_votes
+			WHERE session_poll_votes.option_id=session_poll_options.id
+		) as count_votes
+		FROM session_poll_options
+		JOIN session_polls ON session_polls.id=session_poll_options.poll_id
+		WHERE %s = $1
+		ORDER BY session_poll_options.position ASC
+	`, column)
+
+	options := []entity.SessionPollOption{}
+	err := s.db.SelectContext(ctx, &options, query, value)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollRepository][findOptions]")
+
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func NewSessionPollRepository(db *sqlx.DB) contracts.SessionPollRepository {
+	return &sessionPollRepository{
+		db: db,
+	}
+}
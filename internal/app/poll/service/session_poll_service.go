@@ -0,0 +1,433 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/pubsub"
+	uuidPkg "github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+	"github.com/google/uuid"
+)
+
+type sessionPollService struct {
+	repo        contracts.SessionPollRepository
+	sessionRepo contracts.SessionRepository
+	pubsub      pubsub.CustomPubSubInterface
+	validator   validator.ValidatorInterface
+	uuidPkg     uuidPkg.CustomUUIDInterface
+}
+
+func (s *sessionPollService) GetSessionPolls(
+	ctx context.Context,
+	query dto.GetSessionPollsQuery,
+) (dto.GetSessionPollsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSessionPollsResponse{}, valErr
+	}
+
+	_, isHost, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return dto.GetSessionPollsResponse{}, err
+	}
+
+	polls, err := s.repo.FindAll(ctx, query.SessionID, isHost)
+	if err != nil {
+		return dto.GetSessionPollsResponse{}, err
+	}
+
+	pollsResponse := []dto.SessionPollResponse{}
+	for _, poll := range polls {
+		pollResponse := toSessionPollResponse(&poll)
+
+		if !isHost {
+			countVotes, err := s.repo.CountVotes(ctx, poll.ID, query.UserID)
+			if err != nil {
+				return dto.GetSessionPollsResponse{}, err
+			}
+
+			pollResponse.Voted = countVotes > 0
+		}
+
+		pollsResponse = append(pollsResponse, pollResponse)
+	}
+
+	res := dto.GetSessionPollsResponse{
+		Polls: pollsResponse,
+	}
+
+	return res, nil
+}
+
+func (s *sessionPollService) CreateSessionPoll(
+	ctx context.Context,
+	query dto.CreateSessionPollQuery,
+	req dto.CreateSessionPollRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, _, err := s.authorize(ctx, query.SessionID, req.UserID, 1) // user
+	if err != nil {
+		return err
+	}
+
+	if session.ProposerID != req.UserID {
+		return domain.ErrCantAccessResource
+	}
+
+	if session.Status != 2 { // approved
+		return domain.ErrSessionNotAccepted
+	}
+
+	if session.EndAt.Before(time.Now()) {
+		return domain.ErrSessionAlreadyEnded
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	poll := entity.SessionPoll{
+		ID:        id,
+		SessionID: query.SessionID,
+		Question:  req.Question,
+	}
+
+	for position, label := range req.Options {
+		optionID, err := s.uuidPkg.NewV7()
+		if err != nil {
+			return err
+		}
+
+		poll.Options = append(poll.Options, entity.SessionPollOption{
+			ID:       optionID,
+			PollID:   id,
+			Position: position,
+			Label:    label,
+		})
+	}
+
+	err = s.repo.Create(ctx, &poll)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionPollService) OpenSessionPoll(ctx context.Context, query dto.SessionPollQuery) error {
+	session, poll, err := s.findHostedPoll(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if poll.Status != 1 { // draft
+		return domain.ErrPollAlreadyOpened
+	}
+
+	if !session.IsRunning() {
+		return domain.ErrSessionNotRunning
+	}
+
+	poll.Status = 2 // open
+	poll.OpenedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	err = s.repo.Update(ctx, poll)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "poll.opened", poll.ID)
+}
+
+func (s *sessionPollService) CloseSessionPoll(ctx context.Context, query dto.SessionPollQuery) error {
+	_, poll, err := s.findHostedPoll(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if poll.Status != 2 { // open
+		return domain.ErrPollNotOpen
+	}
+
+	poll.Status = 3 // closed
+	poll.ClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	err = s.repo.Update(ctx, poll)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "poll.closed", poll.ID)
+}
+
+func (s *sessionPollService) VoteSessionPoll(
+	ctx context.Context,
+	query dto.SessionPollQuery,
+	req dto.VoteSessionPollRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, isHost, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return err
+	}
+
+	if isHost {
+		return domain.ErrCantAccessResource
+	}
+
+	if session.Status != 2 { // approved
+		return domain.ErrSessionNotAccepted
+	}
+
+	poll, err := s.findPoll(ctx, query.SessionID, query.PollID)
+	if err != nil {
+		return err
+	}
+
+	if poll.Status != 2 { // open
+		return domain.ErrPollNotOpen
+	}
+
+	if !session.IsRunning() {
+		return domain.ErrSessionNotRunning
+	}
+
+	isOption := false
+	for _, option := range poll.Options {
+		if option.ID == req.OptionID {
+			isOption = true
+		}
+	}
+
+	if !isOption {
+		return domain.ErrInvalidPollOption
+	}
+
+	countVotes, err := s.repo.CountVotes(ctx, poll.ID, query.UserID)
+	if err != nil {
+		return err
+	}
+
+	if countVotes > 0 {
+		return domain.ErrPollAlreadyVoted
+	}
+
+	vote := entity.SessionPollVote{
+		PollID:   poll.ID,
+		UserID:   query.UserID,
+		OptionID: req.OptionID,
+	}
+
+	err = s.repo.CreateVote(ctx, &vote)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "poll.voted", poll.ID)
+}
+
+func (s *sessionPollService) SubscribeSessionPolls(
+	ctx context.Context,
+	query dto.SubscribeSessionPollsQuery,
+) (<-chan []byte, func(), error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, nil, valErr
+	}
+
+	_, _, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, unsubscribe := s.pubsub.Subscribe(sessionPollsTopic(query.SessionID))
+
+	return messages, unsubscribe, nil
+}
+
+// Registered attendees vote, while the speaker, event coordinators and admins follow every poll
+// including drafts. Results stay readable whatever happens to the session afterwards, so callers
+// that change polls check the session status themselves.
+func (s *sessionPollService) authorize(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	role int16,
+) (*entity.Session, bool, error) {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, domain.ErrSessionNotFound
+		}
+
+		return nil, false, err
+	}
+
+	if role == 2 || role == 3 || session.ProposerID == userID { // event coordinator, admin or speaker
+		return session, true, nil
+	}
+
+	sessionAttendee, err := s.sessionRepo.FindSessionAttendee(ctx, sessionID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, domain.ErrSessionNotRegistered
+		}
+
+		return nil, false, err
+	}
+
+	if sessionAttendee.Reason.Valid {
+		return nil, false, domain.ErrSessionNotRegistered
+	}
+
+	return session, false, nil
+}
+
+func (s *sessionPollService) findHostedPoll(
+	ctx context.Context,
+	query dto.SessionPollQuery,
+) (*entity.Session, *entity.SessionPoll, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, nil, valErr
+	}
+
+	session, _, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if session.ProposerID != query.UserID {
+		return nil, nil, domain.ErrCantAccessResource
+	}
+
+	poll, err := s.findPoll(ctx, query.SessionID, query.PollID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return session, poll, nil
+}
+
+func (s *sessionPollService) findPoll(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	pollID uuid.UUID,
+) (*entity.SessionPoll, error) {
+	poll, err := s.repo.FindByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPollNotFound
+		}
+
+		return nil, err
+	}
+
+	if poll.SessionID != sessionID {
+		return nil, domain.ErrPollNotFound
+	}
+
+	return poll, nil
+}
+
+func (s *sessionPollService) publish(ctx context.Context, eventType string, pollID uuid.UUID) error {
+	poll, err := s.repo.FindByID(ctx, pollID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(dto.SessionPollEventResponse{
+		Type: eventType,
+		Poll: toSessionPollResponse(poll),
+	})
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionPollService][publish]")
+
+		return err
+	}
+
+	s.pubsub.Publish(sessionPollsTopic(poll.SessionID), payload)
+
+	return nil
+}
+
+func sessionPollsTopic(sessionID uuid.UUID) string {
+	return fmt.Sprintf("sessions:%s:polls", sessionID)
+}
+
+func toSessionPollResponse(poll *entity.SessionPoll) dto.SessionPollResponse {
+	pollResponse := dto.SessionPollResponse{
+		ID:        poll.ID,
+		SessionID: poll.SessionID,
+		Question:  poll.Question,
+		Status:    poll.Status,
+		Options:   []dto.SessionPollOptionResponse{},
+		CreatedAt: poll.CreatedAt,
+	}
+
+	for _, option := range poll.Options {
+		pollResponse.CountVotes += option.CountVotes
+		pollResponse.Options = append(pollResponse.Options, dto.SessionPollOptionResponse{
+			ID:         option.ID,
+			Label:      option.Label,
+			CountVotes: option.CountVotes,
+		})
+	}
+
+	if poll.OpenedAt.Valid {
+		pollResponse.OpenedAt = &poll.OpenedAt.Time
+	}
+
+	if poll.ClosedAt.Valid {
+		pollResponse.ClosedAt = &poll.ClosedAt.Time
+	}
+
+	return pollResponse
+}
+
+func NewSessionPollService(
+	repo contracts.SessionPollRepository,
+	sessionRepo contracts.SessionRepository,
+	pubsub pubsub.CustomPubSubInterface,
+	validator validator.ValidatorInterface,
+	uuidPkg uuidPkg.CustomUUIDInterface,
+) contracts.SessionPollService {
+	return &sessionPollService{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		pubsub:      pubsub,
+		validator:   validator,
+		uuidPkg:     uuidPkg,
+	}
+}
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/stream"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+type sessionQuestionController struct {
+	service contracts.SessionQuestionService
+}
+
+func InitSessionQuestionController(
+	router fiber.Router,
+	service contracts.SessionQuestionService,
+	middleware *middlewares.Middleware,
+) {
+	controller := sessionQuestionController{
+		service: service,
+	}
+
+	questionRouter := router.Group("/sessions/:sessionID/questions")
+
+	questionRouter.Get(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // user, event coordinator, admin
+		controller.GetSessionQuestions,
+	)
+	questionRouter.Get(
+		"/ws",
+		middleware.RequireStreamAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // user, event coordinator, admin
+		controller.SubscribeSessionQuestions,
+		stream.WebSocket(),
+	)
+	questionRouter.Post(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.CreateSessionQuestion,
+	)
+	questionRouter.Post(
+		"/:questionID/upvote",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.UpvoteSessionQuestion,
+	)
+	questionRouter.Delete(
+		"/:questionID/upvote",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.RemoveUpvoteSessionQuestion,
+	)
+	questionRouter.Post(
+		"/:questionID/answer",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // speaker, event coordinator, admin
+		controller.AnswerSessionQuestion,
+	)
+	questionRouter.Post(
+		"/:questionID/hide",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2, 3}), // speaker, event coordinator, admin
+		controller.HideSessionQuestion,
+	)
+}
+
+func (s *sessionQuestionController) GetSessionQuestions(ctx *fiber.Ctx) error {
+	var query dto.GetSessionQuestionsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	res, err := s.service.GetSessionQuestions(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *sessionQuestionController) CreateSessionQuestion(ctx *fiber.Ctx) error {
+	var query dto.CreateSessionQuestionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.CreateSessionQuestionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := s.service.CreateSessionQuestion(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (s *sessionQuestionController) UpvoteSessionQuestion(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionQuestionQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.UpvoteSessionQuestion(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionQuestionController) RemoveUpvoteSessionQuestion(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionQuestionQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.RemoveUpvoteSessionQuestion(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionQuestionController) AnswerSessionQuestion(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionQuestionQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.AnswerSessionQuestion(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionQuestionController) HideSessionQuestion(ctx *fiber.Ctx) error {
+	query, err := s.parseSessionQuestionQuery(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.service.HideSessionQuestion(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *sessionQuestionController) SubscribeSessionQuestions(ctx *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
+	}
+
+	var query dto.SubscribeSessionQuestionsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	messages, unsubscribe, err := s.service.SubscribeSessionQuestions(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return stream.Subscribe(ctx, messages, unsubscribe)
+}
+
+func (s *sessionQuestionController) parseSessionQuestionQuery(ctx *fiber.Ctx) (dto.SessionQuestionQuery, error) {
+	var query dto.SessionQuestionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return dto.SessionQuestionQuery{}, err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return dto.SessionQuestionQuery{}, domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	return query, nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type sessionQuestionRepository struct {
+	db *sqlx.DB
+}
+
+const selectSessionQuestion = `SELECT session_questions.*,
+	users.id as "user.id", users.name as "user.name",
+	(
+		SELECT COUNT(*) FROM session_question_votes
+		WHERE session_question_votes.question_id=session_questions.id
+	) as count_upvotes,
+	EXISTS (
+		SELECT 1 FROM session_question_votes
+		WHERE session_question_votes.question_id=session_questions.id AND session_question_votes.user_id = $2
+	) as upvoted
+	FROM session_questions
+	JOIN users ON users.id=session_questions.user_id
+`
+
+func (s *sessionQuestionRepository) FindAll(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	viewerID uuid.UUID,
+	includeHidden bool,
+	sortBy string,
+) ([]entity.SessionQuestion, error) {
+	query := selectSessionQuestion + " WHERE session_questions.session_id = $1"
+
+	if !includeHidden {
+		query += " AND session_questions.status != 3" // hidden
+	}
+
+	switch sortBy {
+	case "recent":
+		query += " ORDER BY session_questions.created_at DESC"
+	default:
+		query += " ORDER BY session_questions.status ASC, count_upvotes DESC, session_questions.created_at ASC"
+	}
+
+	questions := []entity.SessionQuestion{}
+	err := s.db.SelectContext(ctx, &questions, query, sessionID, viewerID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][FindAll]")
+
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+func (s *sessionQuestionRepository) FindByID(
+	ctx context.Context,
+	id uuid.UUID,
+	viewerID uuid.UUID,
+) (*entity.SessionQuestion, error) {
+	var question entity.SessionQuestion
+	err := s.db.GetContext(ctx, &question, selectSessionQuestion+" WHERE session_questions.id = $1", id, viewerID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][FindByID]")
+
+		return nil, err
+	}
+
+	return &question, nil
+}
+
+func (s *sessionQuestionRepository) Create(ctx context.Context, question *entity.SessionQuestion) error {
+	_, err := s.db.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO session_questions
+		(id, session_id, user_id, content)
+		VALUES (:id, :session_id, :user_id, :content)
+		`,
+		question,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][Create]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionQuestionRepository) Update(ctx context.Context, question *entity.SessionQuestion) error {
+	_, err := s.db.NamedExecContext(
+		ctx,
+		`
+		UPDATE session_questions
+		SET status = :status, answered_at = :answered_at, hidden_at = :hidden_at
+		WHERE id = :id
+		`,
+		question,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][Update]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionQuestionRepository) CountVotes(
+	ctx context.Context,
+	questionID uuid.UUID,
+	userID uuid.UUID,
+) (int64, error) {
+	var count int64
+	err := s.db.GetContext(
+		ctx,
+		&count,
+		"SELECT COUNT(*) FROM session_question_votes WHERE question_id = $1 AND user_id = $2",
+		questionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][CountVotes]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sessionQuestionRepository) CreateVote(ctx context.Context, vote *entity.SessionQuestionVote) error {
+	_, err := s.db.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO session_question_votes
+		(question_id, user_id)
+		VALUES (:question_id, :user_id)
+		`,
+		vote,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][CreateVote]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionQuestionRepository) DeleteVote(ctx context.Context, questionID uuid.UUID, userID uuid.UUID) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		"DELETE FROM session_question_votes WHERE question_id = $1 AND user_id = $2",
+		questionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionRepository][DeleteVote]")
+
+		return err
+	}
+
+	return nil
+}
+
+func NewSessionQuestionRepository(db *sqlx.DB) contracts.SessionQuestionRepository {
+	return &sessionQuestionRepository{
+		db: db,
+	}
+}
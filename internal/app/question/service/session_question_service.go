@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/pubsub"
+	uuidPkg "github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+	"github.com/google/uuid"
+)
+
+type sessionQuestionService struct {
+	repo        contracts.SessionQuestionRepository
+	sessionRepo contracts.SessionRepository
+	pubsub      pubsub.CustomPubSubInterface
+	validator   validator.ValidatorInterface
+	uuidPkg     uuidPkg.CustomUUIDInterface
+}
+
+func (s *sessionQuestionService) GetSessionQuestions(
+	ctx context.Context,
+	query dto.GetSessionQuestionsQuery,
+) (dto.GetSessionQuestionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSessionQuestionsResponse{}, valErr
+	}
+
+	_, isModerator, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return dto.GetSessionQuestionsResponse{}, err
+	}
+
+	questions, err := s.repo.FindAll(ctx, query.SessionID, query.UserID, isModerator, query.SortBy)
+	if err != nil {
+		return dto.GetSessionQuestionsResponse{}, err
+	}
+
+	questionsResponse := []dto.SessionQuestionResponse{}
+	for _, question := range questions {
+		questionsResponse = append(questionsResponse, toSessionQuestionResponse(&question))
+	}
+
+	res := dto.GetSessionQuestionsResponse{
+		Questions: questionsResponse,
+	}
+
+	return res, nil
+}
+
+func (s *sessionQuestionService) CreateSessionQuestion(
+	ctx context.Context,
+	query dto.CreateSessionQuestionQuery,
+	req dto.CreateSessionQuestionRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, isModerator, err := s.authorize(ctx, query.SessionID, req.UserID, 1) // user
+	if err != nil {
+		return err
+	}
+
+	if isModerator {
+		return domain.ErrCantAccessResource
+	}
+
+	if !session.IsRunning() {
+		return domain.ErrSessionNotRunning
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	question := entity.SessionQuestion{
+		ID:        id,
+		SessionID: query.SessionID,
+		UserID:    req.UserID,
+		Content:   req.Content,
+	}
+
+	err = s.repo.Create(ctx, &question)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "question.created", id)
+}
+
+func (s *sessionQuestionService) UpvoteSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error {
+	question, err := s.findVotableQuestion(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if question.Upvoted {
+		return domain.ErrQuestionAlreadyUpvoted
+	}
+
+	vote := entity.SessionQuestionVote{
+		QuestionID: question.ID,
+		UserID:     query.UserID,
+	}
+
+	err = s.repo.CreateVote(ctx, &vote)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "question.upvoted", question.ID)
+}
+
+func (s *sessionQuestionService) RemoveUpvoteSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error {
+	question, err := s.findVotableQuestion(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if !question.Upvoted {
+		return domain.ErrQuestionNotUpvoted
+	}
+
+	err = s.repo.DeleteVote(ctx, question.ID, query.UserID)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "question.upvoted", question.ID)
+}
+
+func (s *sessionQuestionService) AnswerSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error {
+	question, err := s.findModeratedQuestion(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if question.Status != 1 { // open
+		return domain.ErrQuestionNotOpen
+	}
+
+	question.Status = 2 // answered
+	question.AnsweredAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	err = s.repo.Update(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "question.answered", question.ID)
+}
+
+func (s *sessionQuestionService) HideSessionQuestion(ctx context.Context, query dto.SessionQuestionQuery) error {
+	question, err := s.findModeratedQuestion(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if question.Status == 3 { // hidden
+		return domain.ErrQuestionNotOpen
+	}
+
+	question.Status = 3 // hidden
+	question.HiddenAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	err = s.repo.Update(ctx, question)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, "question.hidden", question.ID)
+}
+
+func (s *sessionQuestionService) SubscribeSessionQuestions(
+	ctx context.Context,
+	query dto.SubscribeSessionQuestionsQuery,
+) (<-chan []byte, func(), error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, nil, valErr
+	}
+
+	_, _, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, unsubscribe := s.pubsub.Subscribe(sessionQuestionsTopic(query.SessionID))
+
+	return messages, unsubscribe, nil
+}
+
+// Registered attendees take part in the Q&A, while the speaker, event coordinators and admins
+// moderate it.
+func (s *sessionQuestionService) authorize(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	role int16,
+) (*entity.Session, bool, error) {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, domain.ErrSessionNotFound
+		}
+
+		return nil, false, err
+	}
+
+	if session.Status != 2 { // approved
+		return nil, false, domain.ErrSessionNotAccepted
+	}
+
+	if role == 2 || role == 3 || session.ProposerID == userID { // event coordinator, admin or speaker
+		return session, true, nil
+	}
+
+	sessionAttendee, err := s.sessionRepo.FindSessionAttendee(ctx, sessionID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, domain.ErrSessionNotRegistered
+		}
+
+		return nil, false, err
+	}
+
+	if sessionAttendee.Reason.Valid {
+		return nil, false, domain.ErrSessionNotRegistered
+	}
+
+	return session, false, nil
+}
+
+func (s *sessionQuestionService) findVotableQuestion(
+	ctx context.Context,
+	query dto.SessionQuestionQuery,
+) (*entity.SessionQuestion, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	session, isModerator, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if isModerator {
+		return nil, domain.ErrCantAccessResource
+	}
+
+	if !session.IsRunning() {
+		return nil, domain.ErrSessionNotRunning
+	}
+
+	question, err := s.findQuestion(ctx, query.SessionID, query.QuestionID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if question.Status != 1 { // open
+		return nil, domain.ErrQuestionNotOpen
+	}
+
+	return question, nil
+}
+
+func (s *sessionQuestionService) findModeratedQuestion(
+	ctx context.Context,
+	query dto.SessionQuestionQuery,
+) (*entity.SessionQuestion, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	_, isModerator, err := s.authorize(ctx, query.SessionID, query.UserID, query.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isModerator {
+		return nil, domain.ErrCantAccessResource
+	}
+
+	return s.findQuestion(ctx, query.SessionID, query.QuestionID, query.UserID)
+}
+
+func (s *sessionQuestionService) findQuestion(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	questionID uuid.UUID,
+	viewerID uuid.UUID,
+) (*entity.SessionQuestion, error) {
+	question, err := s.repo.FindByID(ctx, questionID, viewerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrQuestionNotFound
+		}
+
+		return nil, err
+	}
+
+	if question.SessionID != sessionID {
+		return nil, domain.ErrQuestionNotFound
+	}
+
+	return question, nil
+}
+
+func (s *sessionQuestionService) publish(ctx context.Context, eventType string, questionID uuid.UUID) error {
+	question, err := s.repo.FindByID(ctx, questionID, uuid.Nil)
+	if err != nil {
+		return err
+	}
+
+	questionResponse := toSessionQuestionResponse(question)
+	if question.Status == 3 { // hidden
+		questionResponse.Content = ""
+		questionResponse.User = dto.UserResponse{}
+	}
+
+	payload, err := json.Marshal(dto.SessionQuestionEventResponse{
+		Type:     eventType,
+		Question: questionResponse,
+	})
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionQuestionService][publish]")
+
+		return err
+	}
+
+	s.pubsub.Publish(sessionQuestionsTopic(question.SessionID), payload)
+
+	return nil
+}
+
+func sessionQuestionsTopic(sessionID uuid.UUID) string {
+	return fmt.Sprintf("sessions:%s:questions", sessionID)
+}
+
+func toSessionQuestionResponse(question *entity.SessionQuestion) dto.SessionQuestionResponse {
+	questionResponse := dto.SessionQuestionResponse{
+		ID:           question.ID,
+		SessionID:    question.SessionID,
+		Content:      question.Content,
+		Status:       question.Status,
+		CountUpvotes: question.CountUpvotes,
+		Upvoted:      question.Upvoted,
+		User: dto.UserResponse{
+			ID:   question.User.ID,
+			Name: question.User.Name,
+		},
+		CreatedAt: question.CreatedAt,
+	}
+
+	if question.AnsweredAt.Valid {
+		questionResponse.AnsweredAt = &question.AnsweredAt.Time
+	}
+
+	return questionResponse
+}
+
+func NewSessionQuestionService(
+	repo contracts.SessionQuestionRepository,
+	sessionRepo contracts.SessionRepository,
+	pubsub pubsub.CustomPubSubInterface,
+	validator validator.ValidatorInterface,
+	uuidPkg uuidPkg.CustomUUIDInterface,
+) contracts.SessionQuestionService {
+	return &sessionQuestionService{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		pubsub:      pubsub,
+		validator:   validator,
+		uuidPkg:     uuidPkg,
+	}
+}
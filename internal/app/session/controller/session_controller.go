@@ -6,6 +6,7 @@ import (
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/stream"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -22,6 +23,20 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 
 	sessionRouter.Get("/", middleware.RequireAuth(),
 		controller.GetSessions)
+	sessionRouter.Get("/seats/stream",
+		middleware.RequireStreamAuth(),
+		controller.StreamSessionSeats,
+	)
+	sessionRouter.Get("/export",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2, 3}), // event coordinator, admin
+		controller.ExportSessions,
+	)
+	sessionRouter.Get("/trash",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.GetDeletedSessions,
+	)
 	sessionRouter.Get("/:id",
 		middleware.RequireAuth(),
 		controller.GetSession,
@@ -30,6 +45,29 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 		middleware.RequireAuth(),
 		controller.GetSessionAttendees,
 	)
+	sessionRouter.Get("/:id/attendees/export",
+		middleware.RequireAuth(),
+		controller.ExportSessionAttendees,
+	)
+
+	eventRouter := router.Group("/events")
+
+	eventRouter.Get("/:id/registrations/export",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2, 3}), // event coordinator, admin
+		controller.ExportEventRegistrations,
+	)
+
+	userRouter := router.Group("/users")
+
+	userRouter.Get("/me/recommendations",
+		middleware.RequireAuth(),
+		controller.GetRecommendations,
+	)
+	userRouter.Get("/me/events/:id/quotas",
+		middleware.RequireAuth(),
+		controller.GetRegistrationQuotas,
+	)
 
 	sessionRouter.Post(
 		"/",
@@ -37,6 +75,19 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 		middleware.RequirePermission([]int16{1}), // user
 		controller.CreateSession,
 	)
+	sessionRouter.Post(
+		"/import",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.ImportSessions,
+	)
+	sessionRouter.Post(
+		"/:id/clone",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.CloneSession,
+	)
 	sessionRouter.Patch(
 		"/:id",
 		middleware.RequireAuth(),
@@ -51,7 +102,80 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 		middleware.AuthorizationSessionProposal(),
 		controller.DeleteSession,
 	)
+	sessionRouter.Get(
+		"/:id/invites",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.GetSessionInvites,
+	)
+	sessionRouter.Post(
+		"/:id/invites",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.CreateSessionInvite,
+	)
+	sessionRouter.Delete(
+		"/:id/invites/:inviteID",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.DeleteSessionInvite,
+	)
+	sessionRouter.Get(
+		"/:id/registrations",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.GetRegistrationRequests,
+	)
+	sessionRouter.Post(
+		"/:id/registrations/approve",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.ApproveRegistrations,
+	)
+	sessionRouter.Post(
+		"/:id/registrations/decline",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.DeclineRegistrations,
+	)
+	sessionRouter.Put(
+		"/:id/registration-questions",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1, 2}), // user, event coordinator
+		middleware.AuthorizationSessionProposal(),
+		controller.UpdateRegistrationQuestions,
+	)
+	sessionRouter.Post(
+		"/:id/restore",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.RestoreSession,
+	)
 
+	sessionRouter.Post(
+		"/bulk/accept",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.BulkAcceptSessions,
+	)
+	sessionRouter.Post(
+		"/bulk/reject",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.BulkRejectSessions,
+	)
+	sessionRouter.Post(
+		"/bulk/cancel",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.BulkCancelSessions,
+	)
 	sessionRouter.Post(
 		"/:id/accept",
 		middleware.RequireAuth(),
@@ -64,12 +188,18 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 		middleware.RequirePermission([]int16{2}), // admin
 		controller.RejectSession,
 	)
-	sessionRouter.Post( // ! IGNORE
+	sessionRouter.Post(
 		"/:id/cancel",
 		middleware.RequireAuth(),
 		middleware.RequirePermission([]int16{2}), // admin
 		controller.CancelSession,
 	)
+	sessionRouter.Post(
+		"/:id/cancel/revert",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.RevertCancelSession,
+	)
 
 	sessionRouter.Post(
 		"/:sessionID/register",
@@ -89,12 +219,48 @@ func InitSessionController(router fiber.Router, service contracts.SessionService
 		middleware.RequirePermission([]int16{1}), // user
 		controller.ReviewSession,
 	)
+	sessionRouter.Patch(
+		"/:sessionID/reviews",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.EditReviewSession,
+	)
+	sessionRouter.Get(
+		"/:sessionID/reviews/:userID/revisions",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.GetReviewRevisions,
+	)
 	sessionRouter.Post(
 		"/:sessionID/reviews/:userID/remove",
 		middleware.RequireAuth(),
 		middleware.RequirePermission([]int16{2}), // admin
 		controller.RemoveReview,
 	)
+	sessionRouter.Post(
+		"/:sessionID/reviews/:userID/report",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.ReportReview,
+	)
+	sessionRouter.Get(
+		"/reviews/reports",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.GetReviewReports,
+	)
+	sessionRouter.Post(
+		"/:sessionID/reviews/:userID/moderate",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.ModerateReview,
+	)
+	sessionRouter.Get(
+		"/:sessionID/reviews/:userID/moderation-logs",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // admin
+		controller.GetReviewModerationLogs,
+	)
 }
 
 /*
@@ -108,6 +274,11 @@ func (c *sessionController) GetSessions(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	if claims, ok := ctx.Locals("claims").(jwt.Claims); ok {
+		query.ViewerID = claims.UserID
+		query.ViewerRole = claims.Role
+	}
+
 	// get user's proposals
 	if query.ProposerID != uuid.Nil {
 		claims, ok := ctx.Locals("claims").(jwt.Claims)
@@ -153,6 +324,18 @@ func (c *sessionController) GetSession(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.ViewerID = claims.UserID
+	query.ViewerRole = claims.Role
+
 	session, err := c.service.GetSession(ctx.Context(), query)
 	if err != nil {
 		return err
@@ -171,6 +354,14 @@ func (c *sessionController) GetSessionAttendees(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.ViewerID = claims.UserID
+	query.ViewerRole = claims.Role
+
 	attendees, err := c.service.GetSessionAttendees(ctx.Context(), query)
 	if err != nil {
 		return err
@@ -179,9 +370,9 @@ func (c *sessionController) GetSessionAttendees(ctx *fiber.Ctx) error {
 	return response.SendResponse(ctx, fiber.StatusOK, attendees)
 }
 
-func (c *sessionController) CreateSession(ctx *fiber.Ctx) error {
-	var req dto.CreateSessionRequest
-	if err := ctx.BodyParser(&req); err != nil {
+func (c *sessionController) ExportSessions(ctx *fiber.Ctx) error {
+	var query dto.ExportSessionsQuery
+	if err := ctx.QueryParser(&query); err != nil {
 		return err
 	}
 
@@ -190,117 +381,158 @@ func (c *sessionController) CreateSession(ctx *fiber.Ctx) error {
 		return domain.ErrClaimsNotFound
 	}
 
-	req.ProposerID = claims.UserID
+	query.Role = claims.Role
 
-	err := c.service.CreateSession(ctx.Context(), req)
+	res, err := c.service.ExportSessions(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+	return stream.File(ctx, res.Filename, res.ContentType, res.Write)
 }
 
-func (c *sessionController) UpdateSession(ctx *fiber.Ctx) error {
-	var req dto.UpdateSessionRequest
-
-	if err := ctx.ParamsParser(&req); err != nil {
+func (c *sessionController) ExportSessionAttendees(ctx *fiber.Ctx) error {
+	var query dto.ExportSessionAttendeesQuery
+	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	if err := ctx.BodyParser(&req); err != nil {
+	if err := ctx.QueryParser(&query); err != nil {
 		return err
 	}
 
-	err := c.service.UpdateSession(ctx.Context(), req)
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.UserID = claims.UserID
+	query.Role = claims.Role
+
+	res, err := c.service.ExportSessionAttendees(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return stream.File(ctx, res.Filename, res.ContentType, res.Write)
 }
 
-func (c *sessionController) DeleteSession(ctx *fiber.Ctx) error {
-	var query dto.DeleteSessionQuery
+func (c *sessionController) ExportEventRegistrations(ctx *fiber.Ctx) error {
+	var query dto.ExportEventRegistrationsQuery
 	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	err := c.service.DeleteSession(ctx.Context(), query)
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.Role = claims.Role
+
+	res, err := c.service.ExportEventRegistrations(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return stream.File(ctx, res.Filename, res.ContentType, res.Write)
 }
 
-func (c *sessionController) AcceptSession(ctx *fiber.Ctx) error {
-	var query dto.AcceptSessionQuery
-	if err := ctx.ParamsParser(&query); err != nil {
+func (c *sessionController) GetRecommendations(ctx *fiber.Ctx) error {
+	var query dto.GetRecommendationsQuery
+	if err := ctx.QueryParser(&query); err != nil {
 		return err
 	}
 
-	var req dto.AcceptSessionRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return err
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
 	}
 
-	err := c.service.AcceptSession(ctx.Context(), query, req)
+	query.UserID = claims.UserID
+
+	res, err := c.service.GetRecommendations(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return response.SendResponse(ctx, fiber.StatusOK, res)
 }
 
-func (c *sessionController) RejectSession(ctx *fiber.Ctx) error {
-	var query dto.RejectSessionQuery
+func (c *sessionController) GetRegistrationQuotas(ctx *fiber.Ctx) error {
+	var query dto.GetRegistrationQuotasQuery
 	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	var req dto.RejectSessionRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return err
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
 	}
 
-	err := c.service.RejectSession(ctx.Context(), query, req)
+	query.UserID = claims.UserID
+
+	res, err := c.service.GetRegistrationQuotas(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return response.SendResponse(ctx, fiber.StatusOK, res)
 }
 
-func (c *sessionController) RegisterSession(ctx *fiber.Ctx) error {
-	var query dto.RegisterSessionQuery
-	if err := ctx.ParamsParser(&query); err != nil {
+func (c *sessionController) CreateSession(ctx *fiber.Ctx) error {
+	var req dto.CreateSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
 		return err
 	}
 
-	var req dto.RegisterSessionRequest
-
 	claims, ok := ctx.Locals("claims").(jwt.Claims)
 	if !ok {
 		return domain.ErrClaimsNotFound
 	}
 
-	req.UserID = claims.UserID
+	req.ProposerID = claims.UserID
 
-	err := c.service.RegisterSession(ctx.Context(), query, req)
+	err := c.service.CreateSession(ctx.Context(), req)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
 }
 
-func (c *sessionController) UnregisterSession(ctx *fiber.Ctx) error {
-	var query dto.UnregisterSessionQuery
+func (c *sessionController) ImportSessions(ctx *fiber.Ctx) error {
+	var req dto.ImportSessionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		return domain.ErrImportFileRequired
+	}
+
+	req.File = file
+
+	res, err := c.service.ImportSessions(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) CloneSession(ctx *fiber.Ctx) error {
+	var query dto.CloneSessionQuery
 	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	var req dto.UnregisterSessionRequest
+	var req dto.CloneSessionRequest
 	if err := ctx.BodyParser(&req); err != nil {
 		return err
 	}
@@ -311,53 +543,70 @@ func (c *sessionController) UnregisterSession(ctx *fiber.Ctx) error {
 	}
 
 	req.UserID = claims.UserID
+	req.Role = claims.Role
 
-	err := c.service.UnregisterSession(ctx.Context(), query, req)
+	err := c.service.CloneSession(ctx.Context(), query, req)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
 }
 
-func (c *sessionController) ReviewSession(ctx *fiber.Ctx) error {
-	var query dto.ReviewSessionQuery
-	if err := ctx.ParamsParser(&query); err != nil {
+func (c *sessionController) UpdateSession(ctx *fiber.Ctx) error {
+	var req dto.UpdateSessionRequest
+
+	if err := ctx.ParamsParser(&req); err != nil {
 		return err
 	}
 
-	var req dto.ReviewSessionRequest
 	if err := ctx.BodyParser(&req); err != nil {
 		return err
 	}
 
-	claims, ok := ctx.Locals("claims").(jwt.Claims)
-	if !ok {
-		return domain.ErrClaimsNotFound
+	err := c.service.UpdateSession(ctx.Context(), req)
+	if err != nil {
+		return err
 	}
 
-	req.UserID = claims.UserID
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
 
-	err := c.service.ReviewSession(ctx.Context(), query, req)
+func (c *sessionController) GetDeletedSessions(ctx *fiber.Ctx) error {
+	var query dto.GetDeletedSessionsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetDeletedSessions(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
 
-	return response.SendResponse(ctx, fiber.StatusOK, nil)
+	return response.SendResponse(ctx, fiber.StatusOK, res)
 }
 
-func (c *sessionController) RemoveReview(ctx *fiber.Ctx) error {
-	var query dto.DeleteReviewSessionQuery
+func (c *sessionController) RestoreSession(ctx *fiber.Ctx) error {
+	var query dto.RestoreSessionQuery
 	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	var req dto.DeleteReviewSessionRequest
-	if err := ctx.BodyParser(&req); err != nil {
+	err := c.service.RestoreSession(ctx.Context(), query)
+	if err != nil {
 		return err
 	}
 
-	err := c.service.DeleteReviewSession(ctx.Context(), query, req)
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) DeleteSession(ctx *fiber.Ctx) error {
+	var query dto.DeleteSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := c.service.DeleteSession(ctx.Context(), query)
 	if err != nil {
 		return err
 	}
@@ -365,16 +614,501 @@ func (c *sessionController) RemoveReview(ctx *fiber.Ctx) error {
 	return response.SendResponse(ctx, fiber.StatusOK, nil)
 }
 
-func (c *sessionController) CancelSession(ctx *fiber.Ctx) error {
-	var query dto.CancelSessionQuery
+func (c *sessionController) AcceptSession(ctx *fiber.Ctx) error {
+	var query dto.AcceptSessionQuery
 	if err := ctx.ParamsParser(&query); err != nil {
 		return err
 	}
 
-	err := c.service.CancelSession(ctx.Context(), query)
+	var req dto.AcceptSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err := c.service.AcceptSession(ctx.Context(), query, req)
 	if err != nil {
 		return err
 	}
 
 	return response.SendResponse(ctx, fiber.StatusOK, nil)
 }
+
+func (c *sessionController) RejectSession(ctx *fiber.Ctx) error {
+	var query dto.RejectSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.RejectSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err := c.service.RejectSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) RegisterSession(ctx *fiber.Ctx) error {
+	var query dto.RegisterSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.RegisterSessionRequest
+
+	// the body is optional, only invite codes and registration answers go in it
+	if len(ctx.Body()) != 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return err
+		}
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	res, err := c.service.RegisterSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) UnregisterSession(ctx *fiber.Ctx) error {
+	var query dto.UnregisterSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.UnregisterSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := c.service.UnregisterSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) ReviewSession(ctx *fiber.Ctx) error {
+	var query dto.ReviewSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.ReviewSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := c.service.ReviewSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) EditReviewSession(ctx *fiber.Ctx) error {
+	var query dto.EditReviewSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.EditReviewSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := c.service.EditReviewSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) GetReviewRevisions(ctx *fiber.Ctx) error {
+	var query dto.GetReviewRevisionsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetReviewRevisions(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) RemoveReview(ctx *fiber.Ctx) error {
+	var query dto.DeleteReviewSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.DeleteReviewSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.ModeratorID = claims.UserID
+
+	err := c.service.DeleteReviewSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) BulkAcceptSessions(ctx *fiber.Ctx) error {
+	var req dto.BulkAcceptSessionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	res, err := c.service.BulkAcceptSessions(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) BulkRejectSessions(ctx *fiber.Ctx) error {
+	var req dto.BulkRejectSessionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	res, err := c.service.BulkRejectSessions(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) BulkCancelSessions(ctx *fiber.Ctx) error {
+	var req dto.BulkCancelSessionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	res, err := c.service.BulkCancelSessions(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) CancelSession(ctx *fiber.Ctx) error {
+	var query dto.CancelSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.CancelSessionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	err := c.service.CancelSession(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) RevertCancelSession(ctx *fiber.Ctx) error {
+	var query dto.RevertCancelSessionQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := c.service.RevertCancelSession(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) ReportReview(ctx *fiber.Ctx) error {
+	var query dto.ReportReviewQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.ReportReviewRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.ReporterID = claims.UserID
+
+	err := c.service.ReportReview(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (c *sessionController) GetReviewReports(ctx *fiber.Ctx) error {
+	var query dto.GetReviewReportsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetReviewReports(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) ModerateReview(ctx *fiber.Ctx) error {
+	var query dto.ModerateReviewQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.ModerateReviewRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.ModeratorID = claims.UserID
+
+	err := c.service.ModerateReview(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) GetReviewModerationLogs(ctx *fiber.Ctx) error {
+	var query dto.GetReviewModerationLogsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetReviewModerationLogs(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) StreamSessionSeats(ctx *fiber.Ctx) error {
+	var query dto.SubscribeSessionSeatsQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.ViewerID = claims.UserID
+	query.ViewerRole = claims.Role
+
+	messages, unsubscribe, err := c.service.SubscribeSessionSeats(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return stream.EventStream(ctx, "seats", messages, unsubscribe)
+}
+
+func (c *sessionController) GetSessionInvites(ctx *fiber.Ctx) error {
+	var query dto.GetSessionInvitesQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetSessionInvites(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) CreateSessionInvite(ctx *fiber.Ctx) error {
+	var query dto.CreateSessionInviteQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.CreateSessionInviteRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	res, err := c.service.CreateSessionInvite(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, res)
+}
+
+func (c *sessionController) DeleteSessionInvite(ctx *fiber.Ctx) error {
+	var query dto.DeleteSessionInviteQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := c.service.DeleteSessionInvite(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (c *sessionController) GetRegistrationRequests(ctx *fiber.Ctx) error {
+	var query dto.GetRegistrationRequestsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := c.service.GetRegistrationRequests(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) ApproveRegistrations(ctx *fiber.Ctx) error {
+	var query dto.DecideRegistrationsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.ApproveRegistrationsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.DeciderID = claims.UserID
+
+	res, err := c.service.ApproveRegistrations(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) DeclineRegistrations(ctx *fiber.Ctx) error {
+	var query dto.DecideRegistrationsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.DeclineRegistrationsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.DeciderID = claims.UserID
+
+	res, err := c.service.DeclineRegistrations(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (c *sessionController) UpdateRegistrationQuestions(ctx *fiber.Ctx) error {
+	var query dto.UpdateRegistrationQuestionsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.UpdateRegistrationQuestionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	res, err := c.service.UpdateRegistrationQuestions(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
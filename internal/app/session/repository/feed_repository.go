@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+)
+
+// Finds the latest approved public sessions by approval time, including
+// those cancelled since. Deleted sessions and sessions of deleted speakers
+// drop out of the feed.
+func (s *sessionRepository) FindPublished(
+	ctx context.Context,
+	limit int,
+	sessionType int16,
+	tags int16,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	query := `SELECT
+		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name"
+		FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id
+		WHERE sessions.approved_at IS NOT NULL AND sessions.status IN (2, 4) AND sessions.visibility = 1
+			AND sessions.deleted_at IS NULL AND proposer.deleted_at IS NULL`
+	args := []interface{}{}
+
+	if sessionType != 0 {
+		query += fmt.Sprintf(" AND sessions.type = $%d", len(args)+1)
+		args = append(args, sessionType)
+	}
+
+	if tags != 0 {
+		query += fmt.Sprintf(" AND (sessions.tags & $%d) = $%d", len(args)+1, len(args)+1)
+		args = append(args, tags)
+	}
+
+	query += fmt.Sprintf(" ORDER BY sessions.approved_at DESC, sessions.id DESC LIMIT $%d", len(args)+1)
+	args = append(args, limit)
+
+	err := s.conn(ctx).SelectContext(ctx, &sessions, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindPublished]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
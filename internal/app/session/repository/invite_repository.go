@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+func (s *sessionRepository) CreateSessionInvite(ctx context.Context, invite *entity.SessionInvite) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO session_invites
+		(id, session_id, code, user_id, email_domain, max_uses, expires_at)
+		VALUES (:id, :session_id, :code, :user_id, :email_domain, :max_uses, :expires_at)
+		`,
+		invite,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CreateSessionInvite]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindSessionInvites(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]entity.SessionInvite, error) {
+	invites := []entity.SessionInvite{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&invites,
+		"SELECT * FROM session_invites WHERE session_id = $1 ORDER BY created_at ASC, id ASC",
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindSessionInvites]")
+
+		return nil, err
+	}
+
+	return invites, nil
+}
+
+func (s *sessionRepository) FindSessionInviteByID(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	id uuid.UUID,
+) (*entity.SessionInvite, error) {
+	var invite entity.SessionInvite
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&invite,
+		"SELECT * FROM session_invites WHERE session_id = $1 AND id = $2",
+		sessionID,
+		id,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindSessionInviteByID]")
+
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// Finds the invite behind a code as long as it has not expired or run out of
+// uses.
+func (s *sessionRepository) FindUsableSessionInvite(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	code string,
+) (*entity.SessionInvite, error) {
+	var invite entity.SessionInvite
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&invite,
+		`SELECT * FROM session_invites
+		WHERE session_id = $1 AND code = $2
+			AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+			AND (max_uses IS NULL OR uses < max_uses)`,
+		sessionID,
+		code,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindUsableSessionInvite]")
+
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// Takes one use of an invite code. The conditions are checked in the same
+// statement so concurrent registrations cannot overrun max_uses; sql.ErrNoRows
+// means the code is no longer usable.
+func (s *sessionRepository) UseSessionInvite(ctx context.Context, sessionID uuid.UUID, code string) error {
+	var id uuid.UUID
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&id,
+		`UPDATE session_invites SET uses = uses + 1
+		WHERE session_id = $1 AND code = $2
+			AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)
+			AND (max_uses IS NULL OR uses < max_uses)
+		RETURNING id`,
+		sessionID,
+		code,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][UseSessionInvite]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) DeleteSessionInvite(ctx context.Context, id uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM session_invites WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][DeleteSessionInvite]")
+
+		return err
+	}
+
+	return nil
+}
+
+// Reports whether the session's allowlist names the user, by ID or by the
+// domain of their email.
+func (s *sessionRepository) IsSessionInvitee(ctx context.Context, sessionID, userID uuid.UUID) (bool, error) {
+	var invited bool
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&invited,
+		"SELECT "+sessionInvitedClause(2)+" FROM sessions WHERE sessions.id = $1",
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][IsSessionInvitee]")
+
+		return false, err
+	}
+
+	return invited, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+// Finds the prerequisites of all the given sessions in one query, keyed by the
+// session that requires them.
+func (s *sessionRepository) FindSessionPrerequisites(
+	ctx context.Context,
+	sessionIDs []uuid.UUID,
+) (map[uuid.UUID][]entity.Session, error) {
+	rows := []struct {
+		entity.Session
+		RequiredBy uuid.UUID `db:"required_by"`
+	}{}
+
+	ids := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		ids = append(ids, sessionID.String())
+	}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&rows,
+		`SELECT sessions.*, session_prerequisites.session_id AS required_by FROM session_prerequisites
+		JOIN sessions ON sessions.id=session_prerequisites.prerequisite_id
+		WHERE session_prerequisites.session_id = ANY($1) AND sessions.deleted_at IS NULL
+		ORDER BY sessions.start_at ASC, sessions.id ASC`,
+		ids,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindSessionPrerequisites]")
+
+		return nil, err
+	}
+
+	prerequisites := map[uuid.UUID][]entity.Session{}
+	for _, row := range rows {
+		prerequisites[row.RequiredBy] = append(prerequisites[row.RequiredBy], row.Session)
+	}
+
+	return prerequisites, nil
+}
+
+func (s *sessionRepository) ReplaceSessionPrerequisites(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	prerequisiteIDs []uuid.UUID,
+) error {
+	_, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM session_prerequisites WHERE session_id = $1", sessionID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][ReplaceSessionPrerequisites]")
+
+		return err
+	}
+
+	for _, prerequisiteID := range prerequisiteIDs {
+		_, err = s.conn(ctx).ExecContext(
+			ctx,
+			"INSERT INTO session_prerequisites (session_id, prerequisite_id) VALUES ($1, $2)",
+			sessionID,
+			prerequisiteID,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionRepository][ReplaceSessionPrerequisites]")
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Finds the sessions that require the given one.
+func (s *sessionRepository) FindDependentSessions(
+	ctx context.Context,
+	prerequisiteID uuid.UUID,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&sessions,
+		`SELECT sessions.* FROM session_prerequisites
+		JOIN sessions ON sessions.id=session_prerequisites.session_id
+		WHERE session_prerequisites.prerequisite_id = $1 AND sessions.deleted_at IS NULL
+		ORDER BY sessions.start_at ASC, sessions.id ASC`,
+		prerequisiteID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindDependentSessions]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Finds the prerequisites the user holds no registration for. There is no
+// check-in, so a registration that was neither withdrawn nor released counts as
+// attendance once the prerequisite is over. Rejected, cancelled and deleted
+// prerequisites can't be attended and are skipped.
+func (s *sessionRepository) FindMissingPrerequisites(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&sessions,
+		`SELECT sessions.* FROM session_prerequisites
+		JOIN sessions ON sessions.id=session_prerequisites.prerequisite_id
+		WHERE session_prerequisites.session_id = $1
+			AND sessions.deleted_at IS NULL AND sessions.status NOT IN (3, 4)
+			AND NOT EXISTS (
+				SELECT 1 FROM session_attendees
+				WHERE session_attendees.session_id=sessions.id AND session_attendees.user_id = $2
+					AND session_attendees.reason IS NULL AND session_attendees.released_at IS NULL
+			)
+		ORDER BY sessions.start_at ASC, sessions.id ASC`,
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindMissingPrerequisites]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
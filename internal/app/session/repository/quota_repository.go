@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+// Serializes the registrations of one user until the transaction ends, so two
+// concurrent registrations can't both slip under a quota.
+func (s *sessionRepository) LockUserRegistrations(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		"SELECT pg_advisory_xact_lock(hashtextextended('registrations:' || $1, 0))",
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][LockUserRegistrations]")
+
+		return err
+	}
+
+	return nil
+}
+
+// Finds the sessions of the event the user holds a seat in or waits for
+// approval of, everything that counts against the event's quotas.
+func (s *sessionRepository) FindHeldRegistrations(
+	ctx context.Context,
+	eventID uuid.UUID,
+	userID uuid.UUID,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&sessions,
+		`SELECT sessions.* FROM sessions
+		WHERE sessions.event_id = $1 AND sessions.deleted_at IS NULL AND sessions.status <> 4
+			AND (
+				EXISTS (
+					SELECT 1 FROM session_attendees
+					WHERE session_attendees.session_id=sessions.id AND session_attendees.user_id = $2
+						AND session_attendees.reason IS NULL AND session_attendees.released_at IS NULL
+				)
+				OR EXISTS (
+					SELECT 1 FROM registration_requests
+					WHERE registration_requests.session_id=sessions.id AND registration_requests.user_id = $2
+						AND registration_requests.status = 1
+				)
+			)
+		ORDER BY sessions.start_at ASC, sessions.id ASC`,
+		eventID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindHeldRegistrations]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
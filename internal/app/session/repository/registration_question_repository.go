@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+func (s *sessionRepository) FindRegistrationQuestions(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]entity.RegistrationQuestion, error) {
+	questions := []entity.RegistrationQuestion{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&questions,
+		"SELECT * FROM registration_questions WHERE session_id = $1 ORDER BY position ASC",
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindRegistrationQuestions]")
+
+		return nil, err
+	}
+
+	options := []entity.RegistrationQuestionOption{}
+
+	err = s.conn(ctx).SelectContext(
+		ctx,
+		&options,
+		`
+		SELECT registration_question_options.* FROM registration_question_options
+		JOIN registration_questions ON registration_questions.id=registration_question_options.question_id
+		WHERE registration_questions.session_id = $1
+		ORDER BY registration_question_options.position ASC
+		`,
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindRegistrationQuestions]")
+
+		return nil, err
+	}
+
+	for i := range questions {
+		for _, option := range options {
+			if option.QuestionID == questions[i].ID {
+				questions[i].Options = append(questions[i].Options, option)
+			}
+		}
+	}
+
+	return questions, nil
+}
+
+// Swaps the whole form of a session for the given questions. Callers run it in
+// a transaction so the session is never left with half a form.
+func (s *sessionRepository) ReplaceRegistrationQuestions(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	questions []entity.RegistrationQuestion,
+) error {
+	_, err := s.conn(ctx).ExecContext(ctx, "DELETE FROM registration_questions WHERE session_id = $1", sessionID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][ReplaceRegistrationQuestions]")
+
+		return err
+	}
+
+	for _, question := range questions {
+		_, err = s.conn(ctx).NamedExecContext(
+			ctx,
+			`
+			INSERT INTO registration_questions
+			(id, session_id, position, type, prompt, required)
+			VALUES (:id, :session_id, :position, :type, :prompt, :required)
+			`,
+			question,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionRepository][ReplaceRegistrationQuestions]")
+
+			return err
+		}
+
+		for _, option := range question.Options {
+			_, err = s.conn(ctx).NamedExecContext(
+				ctx,
+				`
+				INSERT INTO registration_question_options
+				(id, question_id, position, label)
+				VALUES (:id, :question_id, :position, :label)
+				`,
+				option,
+			)
+			if err != nil {
+				log.Error(log.LogInfo{
+					"error": err,
+				}, "[SessionRepository][ReplaceRegistrationQuestions]")
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Replaces whatever the user answered before, e.g. for a request they
+// withdrew and sent again.
+func (s *sessionRepository) SaveRegistrationAnswers(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	answers []entity.RegistrationAnswer,
+) error {
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		"DELETE FROM registration_answers WHERE session_id = $1 AND user_id = $2",
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][SaveRegistrationAnswers]")
+
+		return err
+	}
+
+	for _, answer := range answers {
+		_, err = s.conn(ctx).NamedExecContext(
+			ctx,
+			`
+			INSERT INTO registration_answers
+			(id, session_id, user_id, question_id, option_id, text_value)
+			VALUES (:id, :session_id, :user_id, :question_id, :option_id, :text_value)
+			`,
+			answer,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionRepository][SaveRegistrationAnswers]")
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindRegistrationAnswers(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]entity.RegistrationAnswer, error) {
+	answers := []entity.RegistrationAnswer{}
+
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&answers,
+		`
+		SELECT registration_answers.* FROM registration_answers
+		LEFT JOIN registration_question_options ON registration_question_options.id=registration_answers.option_id
+		WHERE registration_answers.session_id = $1
+		ORDER BY registration_answers.user_id ASC, registration_question_options.position ASC
+		`,
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindRegistrationAnswers]")
+
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+func (s *sessionRepository) CountRegistrationAnswers(ctx context.Context, sessionID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&count,
+		"SELECT COUNT(*) FROM registration_answers WHERE session_id = $1",
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountRegistrationAnswers]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
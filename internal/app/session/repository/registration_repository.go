@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+// Locks the session row until the transaction ends so that concurrent
+// approvals see each other's seats.
+func (s *sessionRepository) LockSession(ctx context.Context, id uuid.UUID) error {
+	var lockedID uuid.UUID
+
+	err := s.conn(ctx).GetContext(ctx, &lockedID, "SELECT id FROM sessions WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][LockSession]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) CreateRegistrationRequest(ctx context.Context, request *entity.RegistrationRequest) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO registration_requests
+		(session_id, user_id, status)
+		VALUES (:session_id, :user_id, :status)
+		`,
+		request,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CreateRegistrationRequest]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) UpdateRegistrationRequest(ctx context.Context, request *entity.RegistrationRequest) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		UPDATE registration_requests
+		SET status = :status, reason = :reason, decided_by = :decided_by, decided_at = :decided_at
+		WHERE session_id = :session_id AND user_id = :user_id
+		`,
+		request,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][UpdateRegistrationRequest]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) DeleteRegistrationRequest(ctx context.Context, sessionID, userID uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		"DELETE FROM registration_requests WHERE session_id = $1 AND user_id = $2",
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][DeleteRegistrationRequest]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindRegistrationRequest(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.RegistrationRequest, error) {
+	var request entity.RegistrationRequest
+
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&request,
+		"SELECT * FROM registration_requests WHERE session_id = $1 AND user_id = $2",
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindRegistrationRequest]")
+
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// Lists the requests of a session oldest first, the order they are queued in.
+func (s *sessionRepository) FindRegistrationRequests(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	status int16,
+	limit int,
+	offset int,
+) ([]entity.RegistrationRequest, error) {
+	requests := []entity.RegistrationRequest{}
+
+	query := `SELECT registration_requests.*, users.id as "user.id", users.name as "user.name",
+		users.email as "user.email", users.role as "user.role"
+		FROM registration_requests
+		JOIN users ON users.id=registration_requests.user_id
+		WHERE registration_requests.session_id = $1`
+	args := []interface{}{sessionID}
+
+	if status != 0 {
+		query += fmt.Sprintf(" AND registration_requests.status = $%d", len(args)+1)
+		args = append(args, status)
+	}
+
+	query += fmt.Sprintf(
+		" ORDER BY registration_requests.created_at ASC, registration_requests.user_id ASC LIMIT $%d OFFSET $%d",
+		len(args)+1,
+		len(args)+2,
+	)
+	args = append(args, limit, offset)
+
+	err := s.conn(ctx).SelectContext(ctx, &requests, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindRegistrationRequests]")
+
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (s *sessionRepository) CountRegistrationRequests(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	status int16,
+) (int64, error) {
+	var count int64
+
+	query := "SELECT COUNT(*) FROM registration_requests WHERE session_id = $1"
+	args := []interface{}{sessionID}
+
+	if status != 0 {
+		query += fmt.Sprintf(" AND status = $%d", len(args)+1)
+		args = append(args, status)
+	}
+
+	err := s.conn(ctx).GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountRegistrationRequests]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
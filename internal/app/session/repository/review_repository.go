@@ -0,0 +1,277 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+func (s *sessionRepository) CreateReviewReport(ctx context.Context, report *entity.ReviewReport) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO review_reports
+		(id, session_id, user_id, reporter_id, reason)
+		VALUES (:id, :session_id, :user_id, :reporter_id, :reason)
+		`,
+		report,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CreateReviewReport]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) CountReviewReports(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	reporterID uuid.UUID,
+) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM review_reports WHERE resolved_at IS NULL"
+	args := []interface{}{}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	if userID != uuid.Nil {
+		query += fmt.Sprintf(" AND user_id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	if reporterID != uuid.Nil {
+		query += fmt.Sprintf(" AND reporter_id = $%d", len(args)+1)
+		args = append(args, reporterID)
+	}
+
+	err := s.conn(ctx).GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountReviewReports]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sessionRepository) FindReviewReports(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) ([]entity.ReviewReport, error) {
+	query := `SELECT review_reports.*, reporter.id as "reporter.id", reporter.name as "reporter.name",
+		reporter.email as "reporter.email", reporter.role as "reporter.role"
+		FROM review_reports
+		JOIN users reporter ON reporter.id=review_reports.reporter_id
+		WHERE review_reports.session_id = $1 AND review_reports.user_id = $2
+		AND review_reports.resolved_at IS NULL
+		ORDER BY review_reports.created_at ASC
+	`
+
+	reports := []entity.ReviewReport{}
+	err := s.conn(ctx).SelectContext(ctx, &reports, query, sessionID, userID)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindReviewReports]")
+
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func (s *sessionRepository) ResolveReviewReports(ctx context.Context, sessionID, userID uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		`
+		UPDATE review_reports
+		SET resolved_at = CURRENT_TIMESTAMP
+		WHERE session_id = $1 AND user_id = $2 AND resolved_at IS NULL
+		`,
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][ResolveReviewReports]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindFlaggedReviews(
+	ctx context.Context,
+	limit int,
+	offset int,
+) ([]entity.SessionAttendee, error) {
+	query := `SELECT session_attendees.*, sessions.title as "session.title",
+		users.id as "user.id", users.name as "user.name",
+		users.email as "user.email", users.role as "user.role",
+		reports.count_reports
+		FROM session_attendees
+		JOIN sessions ON sessions.id=session_attendees.session_id
+		JOIN users ON users.id=session_attendees.user_id
+		JOIN (
+			SELECT session_id, user_id, COUNT(*) as count_reports, MIN(created_at) as first_reported_at
+			FROM review_reports
+			WHERE resolved_at IS NULL
+			GROUP BY session_id, user_id
+		) reports ON reports.session_id=session_attendees.session_id AND reports.user_id=session_attendees.user_id
+		WHERE session_attendees.review IS NOT NULL
+		ORDER BY reports.count_reports DESC, reports.first_reported_at ASC
+		LIMIT $1 OFFSET $2
+	`
+
+	reviews := []entity.SessionAttendee{}
+	err := s.conn(ctx).SelectContext(ctx, &reviews, query, limit, offset)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindFlaggedReviews]")
+
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (s *sessionRepository) CountFlaggedReviews(ctx context.Context) (int64, error) {
+	var count int64
+	query := `SELECT COUNT(*) FROM (
+		SELECT review_reports.session_id, review_reports.user_id
+		FROM review_reports
+		JOIN session_attendees ON session_attendees.session_id=review_reports.session_id
+			AND session_attendees.user_id=review_reports.user_id
+		WHERE review_reports.resolved_at IS NULL AND session_attendees.review IS NOT NULL
+		GROUP BY review_reports.session_id, review_reports.user_id
+	) flagged`
+
+	err := s.conn(ctx).GetContext(ctx, &count, query)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountFlaggedReviews]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sessionRepository) CreateReviewModerationLog(
+	ctx context.Context,
+	moderationLog *entity.ReviewModerationLog,
+) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO review_moderation_logs
+		(id, session_id, user_id, moderator_id, action, reason)
+		VALUES (:id, :session_id, :user_id, :moderator_id, :action, :reason)
+		`,
+		moderationLog,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CreateReviewModerationLog]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindReviewModerationLogs(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) ([]entity.ReviewModerationLog, error) {
+	logs := []entity.ReviewModerationLog{}
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&logs,
+		`
+		SELECT * FROM review_moderation_logs
+		WHERE session_id = $1 AND user_id = $2
+		ORDER BY created_at DESC
+		`,
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindReviewModerationLogs]")
+
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (s *sessionRepository) CreateReviewRevision(ctx context.Context, revision *entity.ReviewRevision) error {
+	_, err := s.conn(ctx).NamedExecContext(
+		ctx,
+		`
+		INSERT INTO review_revisions
+		(id, session_id, user_id, review)
+		VALUES (:id, :session_id, :user_id, :review)
+		`,
+		revision,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CreateReviewRevision]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) FindReviewRevisions(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) ([]entity.ReviewRevision, error) {
+	revisions := []entity.ReviewRevision{}
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&revisions,
+		`
+		SELECT * FROM review_revisions
+		WHERE session_id = $1 AND user_id = $2
+		ORDER BY created_at DESC
+		`,
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindReviewRevisions]")
+
+		return nil, err
+	}
+
+	return revisions, nil
+}
@@ -3,27 +3,73 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/database"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
 
+// Joins the search document of each session and the tsquery passed as $1,
+// parsed for every language the document is indexed in.
+const sessionSearchJoin = `JOIN session_search ON session_search.session_id=sessions.id
+	CROSS JOIN LATERAL (
+		SELECT to_tsquery('simple', $1) || to_tsquery('english', $1) || to_tsquery('indonesian', $1) as query
+	) search`
+
+// Matches sessions whose allowlist names the user bound at $n, by ID or by
+// the domain of their email.
+func sessionInvitedClause(n int) string {
+	return fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM session_invites JOIN users invitee ON invitee.id = $%[1]d
+			WHERE session_invites.session_id=sessions.id AND (
+				session_invites.user_id=invitee.id
+				OR session_invites.email_domain=lower(split_part(invitee.email, '@', 2))
+			)
+		)`, n)
+}
+
+// Turns free text into a prefix tsquery, "data sci" becomes "data:* & sci:*",
+// so sessions match while the user is still typing.
+func searchTsQuery(search string) string {
+	terms := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
+
 type sessionRepository struct {
 	db *sqlx.DB
 }
 
+func (s *sessionRepository) conn(ctx context.Context) database.Executor {
+	return database.Conn(ctx, s.db)
+}
+
+func (s *sessionRepository) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return database.RunInTx(ctx, s.db, fn)
+}
+
 func (s *sessionRepository) Create(ctx context.Context, session *entity.Session) error {
-	_, err := s.db.NamedExecContext(
+	_, err := s.conn(ctx).NamedExecContext(
 		ctx,
 		`
 		INSERT INTO sessions
-		(id, title, description, start_at, end_at, type, tags, proposer_id, room, meeting_url, capacity)
-		VALUES (:id, :title, :description, :start_at, :end_at, :type, :tags,
-			:proposer_id, :room, :meeting_url, :capacity)
+		(id, title, description, start_at, end_at, time_zone, type, tags, status, visibility, proposer_id, room,
+			meeting_url, capacity, requires_approval, event_id)
+		VALUES (:id, :title, :description, :start_at, :end_at, :time_zone, :type, :tags, :status, :visibility,
+			:proposer_id, :room, :meeting_url, :capacity, :requires_approval, :event_id)
 		`,
 		session,
 	)
@@ -40,7 +86,11 @@ func (s *sessionRepository) Create(ctx context.Context, session *entity.Session)
 }
 
 func (s *sessionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := s.db.ExecContext(ctx, "DELETE FROM sessions WHERE id = $1", id)
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		"UPDATE sessions SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL",
+		id,
+	)
 	if err != nil {
 		log.Error(log.LogInfo{
 			"error": err,
@@ -52,12 +102,118 @@ func (s *sessionRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (s *sessionRepository) FindAll(
-	ctx context.Context,
-	limit int,
-	offset int,
-	sortBy string,
-	sortOrder string,
+func (s *sessionRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(ctx, "UPDATE sessions SET deleted_at = NULL WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][Restore]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	res, err := s.conn(ctx).ExecContext(
+		ctx,
+		"DELETE FROM sessions WHERE deleted_at IS NOT NULL AND deleted_at < $1",
+		deletedBefore,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][Purge]")
+
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (s *sessionRepository) FindAllDeleted(ctx context.Context, limit, offset int) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+	query := `SELECT
+		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
+		proposer.email as "proposer.email", proposer.role as "proposer.role"
+		FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id
+		WHERE sessions.deleted_at IS NOT NULL
+		ORDER BY sessions.deleted_at DESC LIMIT $1 OFFSET $2
+	`
+
+	err := s.conn(ctx).SelectContext(ctx, &sessions, query, limit, offset)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindAllDeleted]")
+
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (s *sessionRepository) CountDeleted(ctx context.Context) (int64, error) {
+	var count int64
+	err := s.conn(ctx).GetContext(ctx, &count, "SELECT COUNT(*) FROM sessions WHERE deleted_at IS NOT NULL")
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountDeleted]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *sessionRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	var session entity.Session
+	err := s.conn(ctx).GetContext(
+		ctx,
+		&session,
+		"SELECT * FROM sessions WHERE id = $1 AND deleted_at IS NOT NULL",
+		id,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindDeletedByID]")
+
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// Sort keys of session listings and the expressions they order by. Room is
+// coalesced since a NULL never compares in a keyset condition.
+var sessionSortColumns = map[string]string{
+	"id":       "sessions.id",
+	"title":    "sessions.title",
+	"start_at": "sessions.start_at",
+	"end_at":   "sessions.end_at",
+	"room":     "coalesce(sessions.room, '')",
+	"capacity": "sessions.capacity",
+}
+
+// Sort keys of attendee listings. The session columns only order attendees
+// listed across sessions, the user ID breaks ties.
+var sessionAttendeeSortColumns = map[string]string{
+	"id":       "sessions.id",
+	"title":    "sessions.title",
+	"start_at": "sessions.start_at",
+	"end_at":   "sessions.end_at",
+	"capacity": "sessions.capacity",
+	"user_id":  "session_attendees.user_id",
+}
+
+// Builds the joins and the WHERE clause shared by the session listing
+// queries. The search tsquery, when present, is always bound as $1. Without
+// a viewer every session is listed, otherwise only the public ones and those
+// the viewer proposed, registered for or is invited to.
+func sessionFilterClause(
 	search string,
 	sessionType int16,
 	tags int16,
@@ -66,73 +222,145 @@ func (s *sessionRepository) FindAll(
 	proposerID uuid.UUID,
 	status int16,
 	userID uuid.UUID,
-) ([]entity.Session, error) {
-	sessions := []entity.Session{}
-
-	query := `SELECT
-		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
-		proposer.email as "proposer.email", proposer.role as "proposer.role"
-		FROM sessions
-		JOIN users proposer ON proposer.id=sessions.proposer_id
-		LEFT JOIN session_attendees ON session_attendees.session_id=sessions.id
-		WHERE 1=1
-	`
+	viewerID uuid.UUID,
+) (string, string, []interface{}) {
+	join := ""
+	where := " WHERE sessions.deleted_at IS NULL"
 	args := []interface{}{}
 
-	if search != "" {
-		query += fmt.Sprintf(" AND (title ILIKE $%d)", len(args)+1)
-		args = append(args, "%"+search+"%")
+	tsQuery := searchTsQuery(search)
+	if tsQuery != "" {
+		args = append(args, tsQuery)
+		join = sessionSearchJoin
+		where += " AND session_search.document @@ search.query"
 	}
 
 	if sessionType != 0 {
-		query += fmt.Sprintf(" AND type = $%d", len(args)+1)
+		where += fmt.Sprintf(" AND sessions.type = $%d", len(args)+1)
 		args = append(args, sessionType)
 	}
 
 	if tags != 0 {
-		query += fmt.Sprintf(" AND (tags & $%d) = $%d", len(args)+1, len(args)+1)
-		args = append(args, tags, tags)
+		where += fmt.Sprintf(" AND (sessions.tags & $%d) = $%d", len(args)+1, len(args)+1)
+		args = append(args, tags)
 	}
 
 	if !beforeAt.IsZero() {
-		query += fmt.Sprintf(" AND start_at < $%d", len(args)+1)
+		where += fmt.Sprintf(" AND sessions.start_at < $%d", len(args)+1)
 		args = append(args, beforeAt)
 	}
 
 	if !afterAt.IsZero() {
-		query += fmt.Sprintf(" AND end_at > $%d", len(args)+1)
+		where += fmt.Sprintf(" AND sessions.end_at > $%d", len(args)+1)
 		args = append(args, afterAt)
 	}
 
 	if proposerID != uuid.Nil {
-		query += fmt.Sprintf(" AND proposer_id = $%d", len(args)+1)
+		where += fmt.Sprintf(" AND sessions.proposer_id = $%d", len(args)+1)
 		args = append(args, proposerID)
 	}
 
 	if status != 0 {
-		query += fmt.Sprintf(" AND status = $%d", len(args)+1)
+		where += fmt.Sprintf(" AND sessions.status = $%d", len(args)+1)
 		args = append(args, status)
 	}
 
+	// EXISTS rather than a join so a session is listed once however many rows it has
 	if userID != uuid.Nil {
-		query += fmt.Sprintf(" AND session_attendees.user_id = $%d", len(args)+1)
+		where += fmt.Sprintf(` AND EXISTS (
+			SELECT 1 FROM session_attendees
+			WHERE session_attendees.session_id=sessions.id AND session_attendees.user_id = $%d
+		)`, len(args)+1)
 		args = append(args, userID)
 	}
 
-	query += fmt.Sprintf(
-		" AND deleted_at IS NULL ORDER BY %s %s LIMIT $%d OFFSET $%d",
-		sortBy,
-		sortOrder,
-		len(args)+1,
-		len(args)+2,
+	if viewerID != uuid.Nil {
+		where += fmt.Sprintf(` AND (
+			sessions.visibility = 1 OR sessions.proposer_id = $%[1]d
+			OR EXISTS (
+				SELECT 1 FROM session_attendees
+				WHERE session_attendees.session_id=sessions.id AND session_attendees.user_id = $%[1]d
+			)
+			OR (sessions.visibility = 3 AND %[2]s)
+		)`, len(args)+1, sessionInvitedClause(len(args)+1))
+		args = append(args, viewerID)
+	}
+
+	return join, where, args
+}
+
+func (s *sessionRepository) FindAll(
+	ctx context.Context,
+	limit int,
+	offset int,
+	sortBy string,
+	sortOrder string,
+	search string,
+	sessionType int16,
+	tags int16,
+	beforeAt time.Time,
+	afterAt time.Time,
+	proposerID uuid.UUID,
+	status int16,
+	userID uuid.UUID,
+	viewerID uuid.UUID,
+	after *cursor.Cursor,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	join, where, args := sessionFilterClause(
+		search,
+		sessionType,
+		tags,
+		beforeAt,
+		afterAt,
+		proposerID,
+		status,
+		userID,
+		viewerID,
 	)
+
+	isSearching := join != ""
+
+	searchSelect := ""
+	if isSearching {
+		searchSelect = `, ts_headline('simple', sessions.title || ' ' || coalesce(sessions.description, ''), search.query,
+			'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=5, MaxWords=20') as highlight`
+	}
+
+	var orderBy string
+	if sortBy == "relevance" {
+		orderBy = "sessions.start_at ASC"
+		if isSearching {
+			orderBy = "ts_rank_cd(session_search.document, search.query) DESC, sessions.start_at ASC"
+		}
+	} else {
+		column := sessionSortColumns[sortBy]
+
+		var keyset string
+		keyset, args = after.Where(column, "sessions.id", args)
+		where += keyset
+
+		order := after.Order(strings.EqualFold(sortOrder, "desc"))
+		orderBy = fmt.Sprintf("%s %s, sessions.id %s", column, order, order)
+	}
+
+	query := fmt.Sprintf(`SELECT
+		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
+		proposer.email as "proposer.email", proposer.role as "proposer.role"%s
+		FROM sessions
+		JOIN users proposer ON proposer.id=sessions.proposer_id
+		%s
+		%s
+		ORDER BY %s LIMIT $%d OFFSET $%d
+	`, searchSelect, join, where, orderBy, len(args)+1, len(args)+2)
 	args = append(args, limit, offset)
 
 	log.Info(log.LogInfo{
 		"query": query,
 	}, "[SessionRepository] FindAll")
 
-	err := s.db.SelectContext(ctx, &sessions, query, args...)
+	err := s.conn(ctx).SelectContext(ctx, &sessions, query, args...)
 	if err != nil {
 		log.Error(log.LogInfo{
 			"error": err,
@@ -153,62 +381,243 @@ func (s *sessionRepository) Count(
 	afterAt time.Time,
 	proposerID uuid.UUID,
 	status int16,
+	userID uuid.UUID,
+	viewerID uuid.UUID,
 ) (int64, error) {
 	var count int64
-	query := "SELECT COUNT(*) FROM sessions WHERE 1=1"
-	args := []interface{}{}
 
-	if search != "" {
-		query += fmt.Sprintf(" AND (title ILIKE $%d)", len(args)+1)
-		args = append(args, "%"+search+"%")
-	}
+	join, where, args := sessionFilterClause(
+		search,
+		sessionType,
+		tags,
+		beforeAt,
+		afterAt,
+		proposerID,
+		status,
+		userID,
+		viewerID,
+	)
 
-	if sessionType != 0 {
-		query += fmt.Sprintf(" AND type = $%d", len(args)+1)
-		args = append(args, sessionType)
-	}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM sessions %s %s", join, where)
 
-	if tags != 0 {
-		query += fmt.Sprintf(" AND (tags & $%d) = $%d", len(args)+1, len(args)+1)
-		args = append(args, tags, tags)
-	}
+	log.Info(log.LogInfo{
+		"query": query,
+	}, "[SessionRepository] Count")
 
-	if !beforeAt.IsZero() {
-		query += fmt.Sprintf(" AND start_at < $%d", len(args)+1)
-		args = append(args, beforeAt)
+	err := s.conn(ctx).GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][Count]")
+
+		return 0, err
 	}
 
-	if !afterAt.IsZero() {
-		query += fmt.Sprintf(" AND end_at > $%d", len(args)+1)
-		args = append(args, afterAt)
+	return count, nil
+}
+
+// Counts the sessions matching the filters per tag, type, status, day and
+// location in one pass over the filtered rows. A session with several tags
+// is counted once under each of them.
+func (s *sessionRepository) CountFacets(
+	ctx context.Context,
+	search string,
+	sessionType int16,
+	tags int16,
+	beforeAt time.Time,
+	afterAt time.Time,
+	proposerID uuid.UUID,
+	status int16,
+	userID uuid.UUID,
+	viewerID uuid.UUID,
+) ([]entity.SessionFacetCount, error) {
+	facets := []entity.SessionFacetCount{}
+
+	join, where, args := sessionFilterClause(
+		search,
+		sessionType,
+		tags,
+		beforeAt,
+		afterAt,
+		proposerID,
+		status,
+		userID,
+		viewerID,
+	)
+
+	query := fmt.Sprintf(`WITH filtered AS (
+			SELECT sessions.type, sessions.tags, sessions.status,
+				sessions.start_at AT TIME ZONE sessions.time_zone as local_start_at,
+				coalesce(sessions.room, 'online') as location
+			FROM sessions
+			%s
+			%s
+		)
+		SELECT 'tag' as facet, tag.name as value, COUNT(*) as count
+		FROM filtered
+		JOIN (VALUES ('PM', 32), ('PD', 16), ('FE', 8), ('BE', 4), ('DS', 2), ('CP', 1)) tag(name, bit)
+			ON filtered.tags & tag.bit <> 0
+		GROUP BY tag.name
+		UNION ALL
+		SELECT 'type', type::TEXT, COUNT(*) FROM filtered GROUP BY type
+		UNION ALL
+		SELECT 'status', status::TEXT, COUNT(*) FROM filtered GROUP BY status
+		UNION ALL
+		SELECT 'day', to_char(local_start_at, 'YYYY-MM-DD'), COUNT(*) FROM filtered GROUP BY 2
+		UNION ALL
+		SELECT 'location', location, COUNT(*) FROM filtered GROUP BY location
+		ORDER BY facet, count DESC, value
+	`, join, where)
+
+	err := s.conn(ctx).SelectContext(ctx, &facets, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][CountFacets]")
+
+		return nil, err
 	}
 
-	if proposerID != uuid.Nil {
-		query += fmt.Sprintf(" AND proposer_id = $%d", len(args)+1)
-		args = append(args, proposerID)
+	return facets, nil
+}
+
+// Finds approved sessions starting after afterAt that still have seats, that
+// are public or invite the user, and that the user has neither registered for
+// nor overlaps with one of their active registrations.
+func (s *sessionRepository) FindCandidates(
+	ctx context.Context,
+	userID uuid.UUID,
+	afterAt time.Time,
+	limit int,
+) ([]entity.SessionCandidate, error) {
+	candidates := []entity.SessionCandidate{}
+
+	query := `SELECT * FROM (
+			SELECT
+				sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
+				proposer.email as "proposer.email", proposer.role as "proposer.role",
+				(
+					SELECT COUNT(*) FROM session_attendees
+					WHERE session_attendees.session_id=sessions.id AND session_attendees.released_at IS NULL
+				) as count_attendees,
+				(
+					SELECT COUNT(DISTINCT peer.user_id) FROM session_attendees peer
+					JOIN session_attendees shared ON shared.user_id=peer.user_id AND shared.reason IS NULL
+					JOIN session_attendees mine ON mine.session_id=shared.session_id AND mine.reason IS NULL
+					WHERE peer.session_id=sessions.id AND peer.reason IS NULL AND peer.released_at IS NULL
+						AND mine.user_id = $1 AND peer.user_id <> $1
+				) as co_attendees
+			FROM sessions
+			JOIN users proposer ON proposer.id=sessions.proposer_id
+			WHERE sessions.status = 2 AND sessions.deleted_at IS NULL AND sessions.start_at > $2
+				AND (sessions.visibility = 1 OR (sessions.visibility = 3 AND ` + sessionInvitedClause(1) + `))
+				AND NOT EXISTS (
+					SELECT 1 FROM session_attendees
+					JOIN sessions registered ON registered.id=session_attendees.session_id
+					WHERE session_attendees.user_id = $1 AND (
+						registered.id = sessions.id OR (
+							session_attendees.reason IS NULL AND session_attendees.released_at IS NULL
+							AND registered.status = 2 AND registered.deleted_at IS NULL
+							AND registered.start_at < sessions.end_at AND registered.end_at > sessions.start_at
+						)
+					)
+				)
+		) candidates
+		WHERE count_attendees < capacity
+		ORDER BY start_at ASC
+		LIMIT $3`
+
+	err := s.conn(ctx).SelectContext(ctx, &candidates, query, userID, afterAt, limit)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindCandidates]")
+
+		return nil, err
 	}
 
-	if status != 0 {
-		query += fmt.Sprintf(" AND status = $%d", len(args)+1)
-		args = append(args, status)
+	return candidates, nil
+}
+
+// Finds the sessions the user attended before beforeAt and rated at least
+// minRating, the average of their survey scale answers normalized to 0-1.
+func (s *sessionRepository) FindHighlyRated(
+	ctx context.Context,
+	userID uuid.UUID,
+	beforeAt time.Time,
+	minRating float64,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
+
+	query := `SELECT sessions.* FROM sessions
+		JOIN session_attendees ON session_attendees.session_id=sessions.id
+		WHERE session_attendees.user_id = $1 AND session_attendees.reason IS NULL
+			AND sessions.end_at < $2 AND sessions.deleted_at IS NULL
+			AND (
+				SELECT AVG(
+					(survey_answers.scale_value - survey_questions.scale_min)::FLOAT /
+					NULLIF(survey_questions.scale_max - survey_questions.scale_min, 0)
+				)
+				FROM survey_responses
+				JOIN survey_answers ON survey_answers.response_id=survey_responses.id
+				JOIN survey_questions ON survey_questions.id=survey_answers.question_id
+				WHERE survey_responses.session_id=sessions.id AND survey_responses.user_id = $1
+					AND survey_answers.scale_value IS NOT NULL
+			) >= $3`
+
+	err := s.conn(ctx).SelectContext(ctx, &sessions, query, userID, beforeAt, minRating)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindHighlyRated]")
+
+		return nil, err
 	}
 
-	query += " AND deleted_at IS NULL"
+	return sessions, nil
+}
 
-	log.Info(log.LogInfo{
-		"query": query,
-	}, "[SessionRepository] FindAll")
+// Finds the approved sessions a speaker proposed, each with the average of the
+// scale answers given in its surveys, normalized to 0-1. Only public sessions
+// are listed unless includeHidden is set.
+func (s *sessionRepository) FindSpeakerSessions(
+	ctx context.Context,
+	proposerID uuid.UUID,
+	includeHidden bool,
+) ([]entity.Session, error) {
+	sessions := []entity.Session{}
 
-	err := s.db.GetContext(ctx, &count, query, args...)
+	query := `SELECT sessions.*, ratings.average_rating, ratings.count_ratings FROM sessions
+		CROSS JOIN LATERAL (
+			SELECT
+				AVG(
+					(survey_answers.scale_value - survey_questions.scale_min)::FLOAT /
+					NULLIF(survey_questions.scale_max - survey_questions.scale_min, 0)
+				) AS average_rating,
+				COUNT(DISTINCT survey_responses.user_id) AS count_ratings
+			FROM survey_responses
+			JOIN survey_answers ON survey_answers.response_id=survey_responses.id
+			JOIN survey_questions ON survey_questions.id=survey_answers.question_id
+			WHERE survey_responses.session_id=sessions.id AND survey_answers.scale_value IS NOT NULL
+		) ratings
+		WHERE sessions.proposer_id = $1 AND sessions.status = 2 AND sessions.deleted_at IS NULL`
+
+	if !includeHidden {
+		query += " AND sessions.visibility = 1"
+	}
+
+	query += " ORDER BY sessions.start_at DESC, sessions.id DESC"
+
+	err := s.conn(ctx).SelectContext(ctx, &sessions, query, proposerID)
 	if err != nil {
 		log.Error(log.LogInfo{
 			"error": err,
-		}, "[SessionRepository][Count]")
+		}, "[SessionRepository][FindSpeakerSessions]")
 
-		return 0, err
+		return nil, err
 	}
 
-	return count, nil
+	return sessions, nil
 }
 
 func (s *sessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
@@ -216,11 +625,11 @@ func (s *sessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
 		proposer.email as "proposer.email", proposer.role as "proposer.role"
 		FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id
-		WHERE sessions.id = $1 AND deleted_at IS NULL
+		WHERE sessions.id = $1 AND sessions.deleted_at IS NULL
 	`
 
 	var session entity.Session
-	err := s.db.GetContext(ctx, &session, query, id)
+	err := s.conn(ctx).GetContext(ctx, &session, query, id)
 	if err != nil {
 		log.Error(log.LogInfo{
 			"error": err,
@@ -233,13 +642,15 @@ func (s *sessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 }
 
 func (s *sessionRepository) Update(ctx context.Context, session *entity.Session) error {
-	_, err := s.db.NamedExecContext(
+	_, err := s.conn(ctx).NamedExecContext(
 		ctx,
 		`
 		UPDATE sessions
 		SET title = :title, description = :description, type = :type, tags = :tags,
-			start_at = :start_at, end_at = :end_at, room = :room, meeting_url = :meeting_url,
-			capacity = :capacity, status = :status
+			start_at = :start_at, end_at = :end_at, time_zone = :time_zone, room = :room, meeting_url = :meeting_url,
+			capacity = :capacity, requires_approval = :requires_approval, status = :status, visibility = :visibility,
+			event_id = :event_id,
+			cancelled_at = :cancelled_at, cancelled_reason = :cancelled_reason
 		WHERE id = :id
 		`,
 		session,
@@ -256,7 +667,7 @@ func (s *sessionRepository) Update(ctx context.Context, session *entity.Session)
 }
 
 func (s *sessionRepository) CreateSessionAttendee(ctx context.Context, sessionAttendee *entity.SessionAttendee) error {
-	_, err := s.db.NamedExecContext(
+	_, err := s.conn(ctx).NamedExecContext(
 		ctx,
 		`
 		INSERT INTO session_attendees
@@ -277,11 +688,12 @@ func (s *sessionRepository) CreateSessionAttendee(ctx context.Context, sessionAt
 }
 
 func (s *sessionRepository) UpdateSessionAttendee(ctx context.Context, sessionAttendee *entity.SessionAttendee) error {
-	_, err := s.db.NamedExecContext(
+	_, err := s.conn(ctx).NamedExecContext(
 		ctx,
 		`
 		UPDATE session_attendees
-		SET review = :review, reason = :reason, deleted_reason = :deleted_reason
+		SET review = :review, reason = :reason, deleted_reason = :deleted_reason,
+			review_edited_at = :review_edited_at, released_at = :released_at
 		WHERE session_id = :session_id AND user_id = :user_id
 		`,
 		sessionAttendee,
@@ -297,6 +709,70 @@ func (s *sessionRepository) UpdateSessionAttendee(ctx context.Context, sessionAt
 	return nil
 }
 
+func (s *sessionRepository) DeleteSessionAttendee(ctx context.Context, sessionID, userID uuid.UUID) error {
+	_, err := s.conn(ctx).ExecContext(
+		ctx,
+		"DELETE FROM session_attendees WHERE session_id = $1 AND user_id = $2",
+		sessionID,
+		userID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][DeleteSessionAttendee]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionRepository) ReleaseSessionAttendees(ctx context.Context, sessionID uuid.UUID) ([]uuid.UUID, error) {
+	userIDs := []uuid.UUID{}
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&userIDs,
+		`
+		UPDATE session_attendees
+		SET released_at = CURRENT_TIMESTAMP
+		WHERE session_id = $1 AND reason IS NULL AND released_at IS NULL
+		RETURNING user_id
+		`,
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][ReleaseSessionAttendees]")
+
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (s *sessionRepository) FindReleasedSessionAttendees(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]entity.SessionAttendee, error) {
+	sessionAttendees := []entity.SessionAttendee{}
+	err := s.conn(ctx).SelectContext(
+		ctx,
+		&sessionAttendees,
+		"SELECT * FROM session_attendees WHERE session_id = $1 AND released_at IS NOT NULL",
+		sessionID,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][FindReleasedSessionAttendees]")
+
+		return nil, err
+	}
+
+	return sessionAttendees, nil
+}
+
 func (s *sessionRepository) CountAttendees(
 	ctx context.Context,
 	sessionID uuid.UUID,
@@ -308,7 +784,7 @@ func (s *sessionRepository) CountAttendees(
 	var count int64
 	query := `SELECT COUNT(session_attendees.*)
 		FROM session_attendees JOIN sessions ON sessions.id=session_attendees.session_id
-		WHERE 1=1`
+		WHERE session_attendees.released_at IS NULL AND sessions.deleted_at IS NULL`
 	args := []interface{}{}
 
 	if sessionID != uuid.Nil {
@@ -335,7 +811,7 @@ func (s *sessionRepository) CountAttendees(
 		query += " AND session_attendees.reason IS NOT NULL AND session_attendees.deleted_reason IS NULL"
 	}
 
-	err := s.db.GetContext(ctx, &count, query, args...)
+	err := s.conn(ctx).GetContext(ctx, &count, query, args...)
 	if err != nil {
 		log.Error(log.LogInfo{
 			"error": err,
@@ -349,7 +825,7 @@ func (s *sessionRepository) CountAttendees(
 
 func (s *sessionRepository) FindSessionAttendee(ctx context.Context, sessionID, userID uuid.UUID) (*entity.SessionAttendee, error) {
 	var sessionAttende entity.SessionAttendee
-	err := s.db.GetContext(
+	err := s.conn(ctx).GetContext(
 		ctx,
 		&sessionAttende,
 		"SELECT * FROM session_attendees WHERE session_id = $1 AND user_id = $2",
@@ -374,6 +850,7 @@ func (s *sessionRepository) FindSessionAttendees(
 	offset int,
 	sortBy string,
 	sortOrder string,
+	after *cursor.Cursor,
 ) ([]entity.SessionAttendee, error) {
 	query := `SELECT session_attendees.*, sessions.proposer_id as "session.proposer_id", sessions.title as "session.title",
 			sessions.description as "session.description", sessions.type as "session.type",
@@ -401,11 +878,26 @@ func (s *sessionRepository) FindSessionAttendees(
 		args = append(args, userID)
 	}
 
-	query += fmt.Sprintf(" AND session_attendees.deleted_reason IS NULL ORDER BY %s %s LIMIT $%d OFFSET $%d", sortBy, sortOrder, len(args)+1, len(args)+2)
+	column := sessionAttendeeSortColumns[sortBy]
+
+	var keyset string
+	keyset, args = after.Where(column, "session_attendees.user_id", args)
+	query += keyset
+
+	order := after.Order(strings.EqualFold(sortOrder, "desc"))
+	query += fmt.Sprintf(
+		" AND session_attendees.deleted_reason IS NULL AND session_attendees.released_at IS NULL"+
+			" ORDER BY %s %s, session_attendees.user_id %s LIMIT $%d OFFSET $%d",
+		column,
+		order,
+		order,
+		len(args)+1,
+		len(args)+2,
+	)
 	args = append(args, limit, offset)
 
 	sessionAttendees := []entity.SessionAttendee{}
-	err := s.db.SelectContext(
+	err := s.conn(ctx).SelectContext(
 		ctx,
 		&sessionAttendees,
 		query,
@@ -422,6 +914,128 @@ func (s *sessionRepository) FindSessionAttendees(
 	return sessionAttendees, nil
 }
 
+// Walks the registrations of a session, or of every session of an event,
+// without loading them all into memory.
+func (s *sessionRepository) StreamSessionAttendees(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	eventID uuid.UUID,
+	fn func(sessionAttendee entity.SessionAttendee) error,
+) error {
+	query := `SELECT session_attendees.*, sessions.title as "session.title",
+		sessions.start_at as "session.start_at", sessions.end_at as "session.end_at",
+		sessions.room as "session.room",
+		users.id as "user.id", users.name as "user.name", users.email as "user.email"
+		FROM session_attendees
+		JOIN sessions ON sessions.id=session_attendees.session_id
+		JOIN users ON users.id=session_attendees.user_id
+		WHERE session_attendees.released_at IS NULL AND sessions.deleted_at IS NULL`
+	args := []interface{}{}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND session_attendees.session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	if eventID != uuid.Nil {
+		query += fmt.Sprintf(" AND sessions.event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	query += " ORDER BY sessions.start_at, session_attendees.session_id, users.name"
+
+	rows, err := s.conn(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][StreamSessionAttendees]")
+
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sessionAttendee entity.SessionAttendee
+		err = rows.StructScan(&sessionAttendee)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionRepository][StreamSessionAttendees]")
+
+			return err
+		}
+
+		err = fn(sessionAttendee)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Walks every session together with its attendee and review counts.
+func (s *sessionRepository) StreamSessions(
+	ctx context.Context,
+	status int16,
+	eventID uuid.UUID,
+	fn func(session entity.Session) error,
+) error {
+	query := `SELECT
+		sessions.*, proposer.id as "proposer.id", proposer.name as "proposer.name",
+		proposer.email as "proposer.email",
+		(SELECT COUNT(*) FROM session_attendees
+			WHERE session_attendees.session_id=sessions.id
+			AND session_attendees.released_at IS NULL AND session_attendees.reason IS NULL) as count_attendees,
+		(SELECT COUNT(*) FROM session_attendees
+			WHERE session_attendees.session_id=sessions.id
+			AND session_attendees.review IS NOT NULL AND session_attendees.deleted_reason IS NULL) as count_reviews
+		FROM sessions JOIN users proposer ON proposer.id=sessions.proposer_id
+		WHERE sessions.deleted_at IS NULL`
+	args := []interface{}{}
+
+	if status != 0 {
+		query += fmt.Sprintf(" AND sessions.status = $%d", len(args)+1)
+		args = append(args, status)
+	}
+
+	if eventID != uuid.Nil {
+		query += fmt.Sprintf(" AND sessions.event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	query += " ORDER BY sessions.start_at"
+
+	rows, err := s.conn(ctx).QueryxContext(ctx, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionRepository][StreamSessions]")
+
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var session entity.Session
+		err = rows.StructScan(&session)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SessionRepository][StreamSessions]")
+
+			return err
+		}
+
+		err = fn(session)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 func NewSessionRepository(db *sqlx.DB) contracts.SessionRepository {
 	return &sessionRepository{
 		db: db,
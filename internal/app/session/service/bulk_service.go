@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+)
+
+// Returned from inside a transaction to roll back the changes of a dry run.
+var errDryRun = errors.New("dry run")
+
+func (s *sessionService) BulkAcceptSessions(
+	ctx context.Context,
+	req dto.BulkAcceptSessionsRequest,
+) (dto.BulkSessionsResponse, error) {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	res, _ := s.bulkSessions(ctx, req.BulkSessionsRequest, func(ctx context.Context, session *entity.Session) error {
+		return s.acceptSession(ctx, session, req.Overrides)
+	})
+
+	return res, nil
+}
+
+func (s *sessionService) BulkRejectSessions(
+	ctx context.Context,
+	req dto.BulkRejectSessionsRequest,
+) (dto.BulkSessionsResponse, error) {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	res, _ := s.bulkSessions(ctx, req.BulkSessionsRequest, func(ctx context.Context, session *entity.Session) error {
+		return s.rejectSession(ctx, session, req.Reason)
+	})
+
+	return res, nil
+}
+
+func (s *sessionService) BulkCancelSessions(
+	ctx context.Context,
+	req dto.BulkCancelSessionsRequest,
+) (dto.BulkSessionsResponse, error) {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	res, sessions := s.bulkSessions(ctx, req.BulkSessionsRequest, func(ctx context.Context, session *entity.Session) error {
+		return s.cancelSession(ctx, session, req.Reason)
+	})
+
+	for _, session := range sessions {
+		s.publishSessionSeats(ctx, session)
+	}
+
+	return res, nil
+}
+
+// Applies action to every session in the request. Each session is locked as
+// it is read, inside the batch's transaction, like the single session actions
+// do. The sessions whose changes were committed are returned so the caller can
+// publish side effects.
+func (s *sessionService) bulkSessions(
+	ctx context.Context,
+	req dto.BulkSessionsRequest,
+	action func(ctx context.Context, session *entity.Session) error,
+) (dto.BulkSessionsResponse, []*entity.Session) {
+	return runBulk(ctx, s.repo, req, s.findLockedSession, action)
+}
+
+// Looks up and applies action to every ID in the request. In atomic mode all
+// items share one transaction and the first failure rolls back the whole
+// batch, reporting the items before it as rolled back and the ones after it
+// as skipped; in per_item mode each item is committed on its own. A dry run
+// reports the outcome without committing anything. The items whose changes
+// were committed are returned.
+func runBulk[T any](
+	ctx context.Context,
+	repo contracts.SessionRepository,
+	req dto.BulkSessionsRequest,
+	find func(ctx context.Context, id uuid.UUID) (T, error),
+	action func(ctx context.Context, item T) error,
+) (dto.BulkSessionsResponse, []T) {
+	if req.Mode == "" {
+		req.Mode = "atomic"
+	}
+
+	res := dto.BulkSessionsResponse{
+		Mode:    req.Mode,
+		DryRun:  req.DryRun,
+		Results: make([]dto.BulkSessionsResult, 0, len(req.IDs)),
+	}
+
+	apply := func(ctx context.Context, id uuid.UUID) (T, error) {
+		item, err := find(ctx, id)
+		if err != nil {
+			return item, err
+		}
+
+		return item, action(ctx, item)
+	}
+
+	addResult := func(id uuid.UUID, err error) {
+		res.Results = append(res.Results, newBulkSessionsResult(id, err))
+		if err != nil {
+			res.Failed++
+		} else {
+			res.Succeeded++
+		}
+	}
+
+	items := []T{}
+
+	if req.Mode == "per_item" {
+		for _, id := range req.IDs {
+			var item T
+			err := repo.RunInTx(ctx, func(ctx context.Context) error {
+				var err error
+				item, err = apply(ctx, id)
+				if err != nil {
+					return err
+				}
+
+				if req.DryRun {
+					return errDryRun
+				}
+
+				return nil
+			})
+			if errors.Is(err, errDryRun) {
+				err = nil
+			}
+
+			addResult(id, err)
+
+			if err == nil && !req.DryRun {
+				items = append(items, item)
+			}
+		}
+
+		res.Committed = !req.DryRun && res.Succeeded > 0
+
+		return res, items
+	}
+
+	err := repo.RunInTx(ctx, func(ctx context.Context) error {
+		for _, id := range req.IDs {
+			item, err := apply(ctx, id)
+			addResult(id, err)
+			if err != nil {
+				return err
+			}
+
+			items = append(items, item)
+		}
+
+		if req.DryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, errDryRun) {
+			rollBackBulkResults(&res, req.IDs)
+		}
+
+		return res, nil
+	}
+
+	res.Committed = true
+
+	return res, items
+}
+
+// Leaves one result per ID once an atomic batch was rolled back: the items
+// that went through are undone and the ones never reached are skipped.
+func rollBackBulkResults(res *dto.BulkSessionsResponse, ids []uuid.UUID) {
+	for i, result := range res.Results {
+		if !result.Success {
+			continue
+		}
+
+		res.Results[i] = dto.BulkSessionsResult{
+			ID:     result.ID,
+			Status: "rolled_back",
+			Error:  "rolled back because another item failed",
+		}
+		res.Succeeded--
+		res.RolledBack++
+	}
+
+	for _, id := range ids[len(res.Results):] {
+		res.Results = append(res.Results, dto.BulkSessionsResult{
+			ID:     id,
+			Status: "skipped",
+			Error:  "skipped because another item failed",
+		})
+		res.Skipped++
+	}
+}
+
+func newBulkSessionsResult(id uuid.UUID, err error) dto.BulkSessionsResult {
+	if err == nil {
+		return dto.BulkSessionsResult{ID: id, Status: "succeeded", Success: true}
+	}
+
+	var reqErr *domain.RequestError
+	if errors.As(err, &reqErr) {
+		return dto.BulkSessionsResult{ID: id, Status: "failed", StatusCode: reqErr.StatusCode, Error: reqErr.Error()}
+	}
+
+	log.Error(log.LogInfo{
+		"error": err,
+		"id":    id,
+	}, "[SessionService][runBulk]")
+
+	return dto.BulkSessionsResult{
+		ID:         id,
+		Status:     "failed",
+		StatusCode: http.StatusInternalServerError,
+		Error:      http.StatusText(http.StatusInternalServerError),
+	}
+}
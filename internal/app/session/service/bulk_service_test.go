@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/google/uuid"
+)
+
+// Runs transactions in place, so only the outcome runBulk reports is checked.
+type fakeTxRepository struct {
+	contracts.SessionRepository
+}
+
+func (f *fakeTxRepository) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestRunBulk(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	failing := ids[2]
+
+	tests := []struct {
+		name          string
+		mode          string
+		dryRun        bool
+		failing       uuid.UUID
+		wantCommitted bool
+		wantStatuses  []string
+		wantCounts    [4]int // succeeded, failed, rolled back, skipped
+		wantItems     int
+	}{
+		{
+			name:          "atomic",
+			mode:          "atomic",
+			wantCommitted: true,
+			wantStatuses:  []string{"succeeded", "succeeded", "succeeded", "succeeded"},
+			wantCounts:    [4]int{4, 0, 0, 0},
+			wantItems:     4,
+		},
+		{
+			name:          "default mode is atomic",
+			wantCommitted: true,
+			wantStatuses:  []string{"succeeded", "succeeded", "succeeded", "succeeded"},
+			wantCounts:    [4]int{4, 0, 0, 0},
+			wantItems:     4,
+		},
+		{
+			name:         "atomic with a failure",
+			mode:         "atomic",
+			failing:      failing,
+			wantStatuses: []string{"rolled_back", "rolled_back", "failed", "skipped"},
+			wantCounts:   [4]int{0, 1, 2, 1},
+		},
+		{
+			name:         "atomic dry run",
+			mode:         "atomic",
+			dryRun:       true,
+			wantStatuses: []string{"succeeded", "succeeded", "succeeded", "succeeded"},
+			wantCounts:   [4]int{4, 0, 0, 0},
+		},
+		{
+			name:         "atomic dry run with a failure",
+			mode:         "atomic",
+			dryRun:       true,
+			failing:      failing,
+			wantStatuses: []string{"rolled_back", "rolled_back", "failed", "skipped"},
+			wantCounts:   [4]int{0, 1, 2, 1},
+		},
+		{
+			name:          "per item with a failure",
+			mode:          "per_item",
+			failing:       failing,
+			wantCommitted: true,
+			wantStatuses:  []string{"succeeded", "succeeded", "failed", "succeeded"},
+			wantCounts:    [4]int{3, 1, 0, 0},
+			wantItems:     3,
+		},
+		{
+			name:         "per item dry run",
+			mode:         "per_item",
+			dryRun:       true,
+			failing:      failing,
+			wantStatuses: []string{"succeeded", "succeeded", "failed", "succeeded"},
+			wantCounts:   [4]int{3, 1, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := dto.BulkSessionsRequest{IDs: ids, Mode: tt.mode, DryRun: tt.dryRun}
+
+			find := func(_ context.Context, id uuid.UUID) (uuid.UUID, error) {
+				return id, nil
+			}
+
+			action := func(_ context.Context, id uuid.UUID) error {
+				if id == tt.failing {
+					return domain.ErrSessionNotFound
+				}
+
+				return nil
+			}
+
+			res, items := runBulk(context.Background(), &fakeTxRepository{}, req, find, action)
+
+			if res.Committed != tt.wantCommitted {
+				t.Errorf("committed = %v, want %v", res.Committed, tt.wantCommitted)
+			}
+
+			counts := [4]int{res.Succeeded, res.Failed, res.RolledBack, res.Skipped}
+			if counts != tt.wantCounts {
+				t.Errorf("counts = %v, want %v", counts, tt.wantCounts)
+			}
+
+			if len(items) != tt.wantItems {
+				t.Errorf("len(items) = %d, want %d", len(items), tt.wantItems)
+			}
+
+			if len(res.Results) != len(ids) {
+				t.Fatalf("len(results) = %d, want %d", len(res.Results), len(ids))
+			}
+
+			for i, result := range res.Results {
+				if result.ID != ids[i] {
+					t.Errorf("results[%d].ID = %s, want %s", i, result.ID, ids[i])
+				}
+
+				if result.Status != tt.wantStatuses[i] {
+					t.Errorf("results[%d].Status = %q, want %q", i, result.Status, tt.wantStatuses[i])
+				}
+
+				if result.Success != (result.Status == "succeeded") {
+					t.Errorf("results[%d].Success = %v with status %q", i, result.Success, result.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestNewBulkSessionsResult(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     string
+		wantStatusCode int
+	}{
+		{
+			name:       "success",
+			wantStatus: "succeeded",
+		},
+		{
+			name:           "request error",
+			err:            domain.ErrSessionNotFound,
+			wantStatus:     "failed",
+			wantStatusCode: domain.ErrSessionNotFound.StatusCode,
+		},
+		{
+			name:           "unexpected error",
+			err:            errors.New("connection reset"),
+			wantStatus:     "failed",
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := newBulkSessionsResult(id, tt.err)
+
+			if result.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", result.Status, tt.wantStatus)
+			}
+
+			if result.StatusCode != tt.wantStatusCode {
+				t.Errorf("status code = %d, want %d", result.StatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/spreadsheet"
+	"github.com/google/uuid"
+)
+
+var sessionAttendeeExportColumns = []string{
+	"session_id",
+	"session_title",
+	"session_start_at",
+	"session_end_at",
+	"room",
+	"user_id",
+	"name",
+	"email",
+	"status",
+	"withdrawal_reason",
+}
+
+var sessionExportColumns = []string{
+	"id",
+	"title",
+	"description",
+	"type",
+	"tags",
+	"status",
+	"start_at",
+	"end_at",
+	"room",
+	"meeting_url",
+	"capacity",
+	"count_attendees",
+	"count_reviews",
+	"event_id",
+	"proposer_id",
+	"proposer_name",
+	"proposer_email",
+}
+
+// Columns holding personal data or details meant for attendees only, only
+// admins may export them.
+var privateExportColumns = map[string]bool{
+	"email":             true,
+	"withdrawal_reason": true,
+	"meeting_url":       true,
+	"proposer_email":    true,
+}
+
+// Registration question columns are named by question ID, as prompts can clash
+// with other columns or contain the commas that separate requested columns.
+const registrationQuestionExportColumnPrefix = "question:"
+
+func registrationQuestionExportColumn(questionID uuid.UUID) string {
+	return registrationQuestionExportColumnPrefix + questionID.String()
+}
+
+// Answers to registration questions are free text that may hold personal data,
+// so they are private like the columns above.
+func isPrivateExportColumn(column string) bool {
+	return privateExportColumns[column] || strings.HasPrefix(column, registrationQuestionExportColumnPrefix)
+}
+
+func (s *sessionService) ExportSessionAttendees(
+	ctx context.Context,
+	query dto.ExportSessionAttendeesQuery,
+) (dto.ExportResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.ExportResponse{}, valErr
+	}
+
+	session, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ExportResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.ExportResponse{}, err
+	}
+
+	// event coordinators and admins can export every session, speakers only their own
+	if query.Role != 2 && query.Role != 3 && session.ProposerID != query.UserID {
+		return dto.ExportResponse{}, domain.ErrCantAccessResource
+	}
+
+	registrationQuestions, answers, err := s.findRegistrationAnswers(ctx, session.ID)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	// every registration question gets a column of its own
+	available := slices.Clone(sessionAttendeeExportColumns)
+	for _, question := range registrationQuestions {
+		available = append(available, registrationQuestionExportColumn(question.ID))
+	}
+
+	columns, err := selectExportColumns(available, query.Columns, query.Role)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	name := fmt.Sprintf("session-%s-attendees", session.ID)
+	res := s.newExport(name, query.Format, columns, func(ctx context.Context, write func(record []string) error) error {
+		return s.repo.StreamSessionAttendees(ctx, session.ID, uuid.Nil, func(sessionAttendee entity.SessionAttendee) error {
+			return write(sessionAttendeeExportRecord(sessionAttendee, columns, answers[sessionAttendee.UserID]))
+		})
+	})
+
+	return res, nil
+}
+
+func (s *sessionService) ExportEventRegistrations(
+	ctx context.Context,
+	query dto.ExportEventRegistrationsQuery,
+) (dto.ExportResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.ExportResponse{}, valErr
+	}
+
+	err := s.checkEventExists(ctx, query.ID)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	columns, err := selectExportColumns(sessionAttendeeExportColumns, query.Columns, query.Role)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	name := fmt.Sprintf("event-%s-registrations", query.ID)
+	res := s.newExport(name, query.Format, columns, func(ctx context.Context, write func(record []string) error) error {
+		return s.repo.StreamSessionAttendees(ctx, uuid.Nil, query.ID, func(sessionAttendee entity.SessionAttendee) error {
+			return write(sessionAttendeeExportRecord(sessionAttendee, columns, nil))
+		})
+	})
+
+	return res, nil
+}
+
+func (s *sessionService) ExportSessions(ctx context.Context, query dto.ExportSessionsQuery) (dto.ExportResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.ExportResponse{}, valErr
+	}
+
+	if query.EventID != uuid.Nil {
+		err := s.checkEventExists(ctx, query.EventID)
+		if err != nil {
+			return dto.ExportResponse{}, err
+		}
+	}
+
+	columns, err := selectExportColumns(sessionExportColumns, query.Columns, query.Role)
+	if err != nil {
+		return dto.ExportResponse{}, err
+	}
+
+	res := s.newExport("sessions", query.Format, columns, func(ctx context.Context, write func(record []string) error) error {
+		return s.repo.StreamSessions(ctx, query.Status, query.EventID, func(session entity.Session) error {
+			return write(sessionExportRecord(session, columns))
+		})
+	})
+
+	return res, nil
+}
+
+// The rows are only read from the database once the response body is written,
+// on the context the response writer hands over.
+func (s *sessionService) newExport(
+	name string,
+	format string,
+	columns []string,
+	stream func(ctx context.Context, write func(record []string) error) error,
+) dto.ExportResponse {
+	if format == "" {
+		format = "csv"
+	}
+
+	return dto.ExportResponse{
+		Filename:    fmt.Sprintf("%s.%s", name, format),
+		ContentType: spreadsheet.ContentTypes[format],
+		Write: func(ctx context.Context, w io.Writer) error {
+			writer, err := s.spreadsheet.NewWriter(format, w, columns)
+			if err != nil {
+				return err
+			}
+
+			err = stream(ctx, writer.Write)
+			if err != nil {
+				_ = writer.Close()
+
+				return err
+			}
+
+			return writer.Close()
+		},
+	}
+}
+
+// Picks the requested comma separated columns, or every column the role may
+// see when none are requested.
+func selectExportColumns(available []string, requested string, role int16) ([]string, error) {
+	isAdmin := role == 3
+
+	columns := []string{}
+	if requested == "" {
+		for _, column := range available {
+			if isPrivateExportColumn(column) && !isAdmin {
+				continue
+			}
+
+			columns = append(columns, column)
+		}
+
+		return columns, nil
+	}
+
+	for _, column := range strings.Split(requested, ",") {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(available, column) {
+			return nil, domain.ErrInvalidExportColumns
+		}
+
+		if isPrivateExportColumn(column) && !isAdmin {
+			return nil, domain.ErrCantAccessResource
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+func sessionAttendeeExportRecord(
+	sessionAttendee entity.SessionAttendee,
+	columns []string,
+	answers []dto.RegistrationAnswerResponse,
+) []string {
+	record := make([]string, 0, len(columns))
+	for _, column := range columns {
+		var value string
+
+		switch column {
+		case "session_id":
+			value = sessionAttendee.SessionID.String()
+		case "session_title":
+			value = sessionAttendee.Session.Title
+		case "session_start_at":
+			value = sessionAttendee.Session.StartAt.Format(time.RFC3339)
+		case "session_end_at":
+			value = sessionAttendee.Session.EndAt.Format(time.RFC3339)
+		case "room":
+			value = sessionAttendee.Session.Room.String
+		case "user_id":
+			value = sessionAttendee.UserID.String()
+		case "name":
+			value = sessionAttendee.User.Name
+		case "email":
+			value = sessionAttendee.User.Email
+		case "status":
+			value = "registered"
+			if sessionAttendee.Reason.Valid {
+				value = "withdrawn"
+			}
+		case "withdrawal_reason":
+			value = sessionAttendee.Reason.String
+		default:
+			for _, answer := range answers {
+				if registrationQuestionExportColumn(answer.QuestionID) == column {
+					value = cmp.Or(answer.Text, strings.Join(answer.Options, ", "))
+				}
+			}
+		}
+
+		record = append(record, value)
+	}
+
+	return record
+}
+
+func sessionExportRecord(session entity.Session, columns []string) []string {
+	record := make([]string, 0, len(columns))
+	for _, column := range columns {
+		var value string
+
+		switch column {
+		case "id":
+			value = session.ID.String()
+		case "title":
+			value = session.Title
+		case "description":
+			value = session.Description.String
+		case "type":
+			value = strconv.Itoa(int(session.Type))
+		case "tags":
+			value = strings.Join(session.TagsArray(), ",")
+		case "status":
+			value = enums.SessionStatus[session.Status]
+		case "start_at":
+			value = session.StartAt.Format(time.RFC3339)
+		case "end_at":
+			value = session.EndAt.Format(time.RFC3339)
+		case "room":
+			value = session.Room.String
+		case "meeting_url":
+			value = session.MeetingURL.String
+		case "capacity":
+			value = strconv.Itoa(session.Capacity)
+		case "count_attendees":
+			value = strconv.FormatInt(session.CountAttendees, 10)
+		case "count_reviews":
+			value = strconv.FormatInt(session.CountReviews, 10)
+		case "event_id":
+			if session.EventID.Valid {
+				value = session.EventID.UUID.String()
+			}
+		case "proposer_id":
+			value = session.Proposer.ID.String()
+		case "proposer_name":
+			value = session.Proposer.Name
+		case "proposer_email":
+			value = session.Proposer.Email
+		}
+
+		record = append(record, value)
+	}
+
+	return record
+}
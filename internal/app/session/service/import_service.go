@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/spreadsheet"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+	"github.com/google/uuid"
+)
+
+// Fields that can be imported. Unless the request maps a field to another
+// header, it is read from the column with the same name.
+var importSessionFields = []string{
+	"title",
+	"description",
+	"type",
+	"tags",
+	"start_at",
+	"end_at",
+	"room",
+	"meeting_url",
+	"capacity",
+	"event_id",
+	"proposer_email",
+	"time_zone",
+}
+
+var requiredImportSessionFields = map[string]bool{
+	"title":          true,
+	"type":           true,
+	"start_at":       true,
+	"end_at":         true,
+	"capacity":       true,
+	"proposer_email": true,
+}
+
+func (s *sessionService) ImportSessions(
+	ctx context.Context,
+	req dto.ImportSessionsRequest,
+) (dto.ImportSessionsResponse, error) {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return dto.ImportSessionsResponse{}, valErr
+	}
+
+	if req.File == nil {
+		return dto.ImportSessionsResponse{}, domain.ErrImportFileRequired
+	}
+
+	mapping := map[string]string{}
+	if req.Mapping != "" {
+		err := json.Unmarshal([]byte(req.Mapping), &mapping)
+		if err != nil {
+			return dto.ImportSessionsResponse{}, domain.ErrInvalidImportMapping
+		}
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return dto.ImportSessionsResponse{}, err
+	}
+	defer file.Close()
+
+	rows, err := s.spreadsheet.Read(req.File.Filename, file)
+	if err != nil {
+		if errors.Is(err, spreadsheet.ErrUnsupportedFormat) {
+			return dto.ImportSessionsResponse{}, domain.ErrUnsupportedImportFormat
+		}
+
+		return dto.ImportSessionsResponse{}, domain.ErrInvalidImportFile
+	}
+
+	if len(rows) < 2 { // header only
+		return dto.ImportSessionsResponse{}, domain.ErrImportFileEmpty
+	}
+
+	columns, err := importSessionColumns(rows[0], mapping)
+	if err != nil {
+		return dto.ImportSessionsResponse{}, err
+	}
+
+	res := dto.ImportSessionsResponse{
+		Rows: []dto.ImportSessionsRow{},
+	}
+	createReqs := []dto.CreateSessionRequest{}
+	proposers := map[string]uuid.UUID{}
+	events := map[uuid.UUID]error{}
+	eventTimeZones := map[uuid.UUID]string{}
+
+	for i, row := range rows[1:] {
+		cell := func(field string) string {
+			index, ok := columns[field]
+			if !ok || index >= len(row) {
+				return ""
+			}
+
+			return strings.TrimSpace(row[index])
+		}
+
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		// a session of an event is stored in the event's time zone, so its
+		// times are read in that zone too
+		var eventTimeZone string
+		if eventID, err := uuid.Parse(cell("event_id")); err == nil {
+			if _, ok := events[eventID]; !ok {
+				eventTimeZones[eventID], events[eventID] = s.sessionTimeZone(ctx, eventID, "")
+			}
+
+			eventTimeZone = eventTimeZones[eventID]
+		}
+
+		createReq, errs := s.parseImportSessionRow(cell, eventTimeZone)
+
+		email := cell("proposer_email")
+		if email != "" {
+			proposerID, ok := proposers[email]
+			if !ok {
+				proposer, err := s.userRepo.FindByEmail(ctx, email)
+				if err != nil && !errors.Is(err, sql.ErrNoRows) {
+					return dto.ImportSessionsResponse{}, err
+				}
+
+				if err == nil {
+					proposerID = proposer.ID
+				}
+
+				proposers[email] = proposerID
+			}
+
+			if proposerID == uuid.Nil {
+				errs["proposer_email"] = domain.ErrUserNotFound.Error()
+			}
+
+			createReq.ProposerID = proposerID
+		}
+
+		if createReq.EventID != uuid.Nil {
+			eventErr := events[createReq.EventID]
+			if errors.Is(eventErr, domain.ErrEventNotFound) {
+				errs["event_id"] = domain.ErrEventNotFound.Error()
+			} else if eventErr != nil {
+				return dto.ImportSessionsResponse{}, eventErr
+			}
+		}
+
+		importRow := dto.ImportSessionsRow{
+			Row:           i + 2, // 1-based and after the header
+			Title:         createReq.Title,
+			ProposerEmail: email,
+			StartAt:       createReq.StartAt,
+			EndAt:         createReq.EndAt,
+		}
+
+		res.TotalRows++
+		if len(errs) > 0 {
+			importRow.Errors = errs
+			res.InvalidRows++
+		} else {
+			res.ValidRows++
+			createReqs = append(createReqs, createReq)
+		}
+
+		res.Rows = append(res.Rows, importRow)
+	}
+
+	if res.TotalRows == 0 {
+		return dto.ImportSessionsResponse{}, domain.ErrImportFileEmpty
+	}
+
+	if !req.Commit || res.InvalidRows > 0 {
+		return res, nil
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		for _, createReq := range createReqs {
+			err := s.createSession(ctx, createReq, 2) // approved
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dto.ImportSessionsResponse{}, err
+	}
+
+	res.Committed = true
+
+	return res, nil
+}
+
+// Resolves the column index of every importable field from the header row.
+func importSessionColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	for field := range mapping {
+		if !slices.Contains(importSessionFields, field) {
+			return nil, domain.ErrInvalidImportMapping
+		}
+	}
+
+	headerIndex := map[string]int{}
+	for i, name := range header {
+		headerIndex[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := map[string]int{}
+	for _, field := range importSessionFields {
+		name, mapped := mapping[field]
+		if !mapped {
+			name = field
+		}
+
+		index, ok := headerIndex[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			if mapped || requiredImportSessionFields[field] {
+				return nil, domain.ErrInvalidImportMapping
+			}
+
+			continue
+		}
+
+		columns[field] = index
+	}
+
+	return columns, nil
+}
+
+// Builds the create request of one row and validates it with the same rules
+// as CreateSession. Errors are keyed by field. eventTimeZone is the zone of
+// the row's event, empty when it has none.
+func (s *sessionService) parseImportSessionRow(
+	cell func(field string) string,
+	eventTimeZone string,
+) (dto.CreateSessionRequest, map[string]string) {
+	errs := map[string]string{}
+	createReq := dto.CreateSessionRequest{
+		Title:       cell("title"),
+		Description: cell("description"),
+		Room:        cell("room"),
+		MeetingURL:  cell("meeting_url"),
+	}
+
+	if value := cell("type"); value != "" {
+		sessionType, err := strconv.ParseInt(value, 10, 16)
+		if err != nil {
+			errs["type"] = "type must be a number"
+		}
+
+		createReq.Type = int16(sessionType)
+	}
+
+	if value := cell("tags"); value != "" {
+		tags := strings.FieldsFunc(value, func(r rune) bool {
+			return r == ',' || r == ';' || unicode.IsSpace(r)
+		})
+
+		for _, tag := range tags {
+			createReq.Tags = append(createReq.Tags, strings.ToUpper(tag))
+		}
+	}
+
+	// times without an offset are read in the time zone the session is stored
+	// in: the event's, or else the row's
+	loc := timezone.Default
+	if value := cell("time_zone"); value != "" {
+		timeZone, err := time.LoadLocation(value)
+		if err != nil {
+			errs["time_zone"] = "time_zone must be a valid time zone"
+		} else {
+			loc = timeZone
+			createReq.TimeZone = value
+		}
+	}
+
+	if eventTimeZone != "" {
+		loc = timezone.Location(eventTimeZone)
+	}
+
+	if value := cell("start_at"); value != "" {
+		startAt, err := spreadsheet.ParseTime(value, loc)
+		if err != nil {
+			errs["start_at"] = "start_at must be a date time"
+		}
+
+		createReq.StartAt = startAt
+	}
+
+	if value := cell("end_at"); value != "" {
+		endAt, err := spreadsheet.ParseTime(value, loc)
+		if err != nil {
+			errs["end_at"] = "end_at must be a date time"
+		}
+
+		createReq.EndAt = endAt
+	}
+
+	if value := cell("capacity"); value != "" {
+		capacity, err := strconv.Atoi(value)
+		if err != nil {
+			errs["capacity"] = "capacity must be a number"
+		}
+
+		createReq.Capacity = capacity
+	}
+
+	if value := cell("event_id"); value != "" {
+		eventID, err := uuid.Parse(value)
+		if err != nil {
+			errs["event_id"] = "event_id must be a valid UUID"
+		}
+
+		createReq.EventID = eventID
+	}
+
+	if cell("proposer_email") == "" {
+		errs["proposer_email"] = "proposer_email is a required field"
+	}
+
+	valErr := s.validator.Validate(createReq)
+	for field, fieldErr := range valErr["body"].Fields {
+		if _, ok := errs[field]; !ok {
+			errs[field] = fieldErr.Message
+		}
+	}
+
+	return createReq, errs
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+func (s *sessionService) GetSessionInvites(
+	ctx context.Context,
+	query dto.GetSessionInvitesQuery,
+) (dto.GetSessionInvitesResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSessionInvitesResponse{}, valErr
+	}
+
+	_, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetSessionInvitesResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.GetSessionInvitesResponse{}, err
+	}
+
+	invites, err := s.repo.FindSessionInvites(ctx, query.ID)
+	if err != nil {
+		return dto.GetSessionInvitesResponse{}, err
+	}
+
+	invitesResponse := []dto.SessionInviteResponse{}
+	for _, invite := range invites {
+		invitesResponse = append(invitesResponse, newSessionInviteResponse(invite))
+	}
+
+	res := dto.GetSessionInvitesResponse{
+		Invites: invitesResponse,
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) CreateSessionInvite(
+	ctx context.Context,
+	query dto.CreateSessionInviteQuery,
+	req dto.CreateSessionInviteRequest,
+) (dto.CreateSessionInviteResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.CreateSessionInviteResponse{}, valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return dto.CreateSessionInviteResponse{}, valErr
+	}
+
+	_, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.CreateSessionInviteResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.CreateSessionInviteResponse{}, err
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return dto.CreateSessionInviteResponse{}, err
+	}
+
+	invite := entity.SessionInvite{
+		ID:        id,
+		SessionID: query.ID,
+		CreatedAt: time.Now(),
+	}
+
+	switch req.Type {
+	case "code":
+		code, err := generateInviteCode()
+		if err != nil {
+			return dto.CreateSessionInviteResponse{}, err
+		}
+
+		invite.Code = sql.NullString{String: code, Valid: true}
+		invite.MaxUses = sql.NullInt32{Int32: int32(req.MaxUses), Valid: req.MaxUses != 0}
+		invite.ExpiresAt = sql.NullTime{Time: req.ExpiresAt, Valid: !req.ExpiresAt.IsZero()}
+	case "user":
+		_, err := s.userRepo.FindByID(ctx, req.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return dto.CreateSessionInviteResponse{}, domain.ErrUserNotFound
+			}
+
+			return dto.CreateSessionInviteResponse{}, err
+		}
+
+		invite.UserID = uuid.NullUUID{UUID: req.UserID, Valid: true}
+	case "email_domain":
+		// stored the way the allowlist check reads it off the user's email
+		emailDomain := strings.ToLower(strings.TrimPrefix(req.EmailDomain, "@"))
+		invite.EmailDomain = sql.NullString{String: emailDomain, Valid: true}
+	}
+
+	// codes are unique by construction, allowlist entries are checked here
+	if req.Type != "code" {
+		invites, err := s.repo.FindSessionInvites(ctx, query.ID)
+		if err != nil {
+			return dto.CreateSessionInviteResponse{}, err
+		}
+
+		for _, existing := range invites {
+			if (invite.UserID.Valid && existing.UserID == invite.UserID) ||
+				(invite.EmailDomain.Valid && existing.EmailDomain == invite.EmailDomain) {
+				return dto.CreateSessionInviteResponse{}, domain.ErrSessionInviteAlreadyExists
+			}
+		}
+	}
+
+	err = s.repo.CreateSessionInvite(ctx, &invite)
+	if err != nil {
+		return dto.CreateSessionInviteResponse{}, err
+	}
+
+	res := dto.CreateSessionInviteResponse{
+		Invite: newSessionInviteResponse(invite),
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) DeleteSessionInvite(ctx context.Context, query dto.DeleteSessionInviteQuery) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	invite, err := s.repo.FindSessionInviteByID(ctx, query.ID, query.InviteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionInviteNotFound
+		}
+
+		return err
+	}
+
+	err = s.repo.DeleteSessionInvite(ctx, invite.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Public and unlisted sessions are open to anyone holding the link. An
+// invite-only session is shown to event coordinators, admins, its proposer,
+// its registrants and its invitees, or to anyone with a usable invite code.
+func (s *sessionService) canViewSession(
+	ctx context.Context,
+	session *entity.Session,
+	viewerID uuid.UUID,
+	viewerRole int16,
+	inviteCode string,
+) (bool, error) {
+	if session.Visibility != 3 { // invite only
+		return true, nil
+	}
+
+	if viewerRole == 2 || viewerRole == 3 || session.ProposerID == viewerID {
+		return true, nil
+	}
+
+	_, err := s.repo.FindSessionAttendee(ctx, session.ID, viewerID)
+	if err == nil {
+		return true, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	invited, err := s.repo.IsSessionInvitee(ctx, session.ID, viewerID)
+	if err != nil {
+		return false, err
+	}
+
+	if invited || inviteCode == "" {
+		return invited, nil
+	}
+
+	_, err = s.repo.FindUsableSessionInvite(ctx, session.ID, inviteCode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// 80 random bits, base32 so the code survives being read out or typed in.
+func generateInviteCode() (string, error) {
+	b := make([]byte, 10)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+func newSessionInviteResponse(invite entity.SessionInvite) dto.SessionInviteResponse {
+	res := dto.SessionInviteResponse{
+		ID:          invite.ID,
+		Code:        invite.Code.String,
+		EmailDomain: invite.EmailDomain.String,
+		Uses:        invite.Uses,
+		CreatedAt:   invite.CreatedAt,
+	}
+
+	if invite.UserID.Valid {
+		res.UserID = &invite.UserID.UUID
+	}
+
+	if invite.MaxUses.Valid {
+		maxUses := int(invite.MaxUses.Int32)
+		res.MaxUses = &maxUses
+	}
+
+	if invite.ExpiresAt.Valid {
+		res.ExpiresAt = &invite.ExpiresAt.Time
+	}
+
+	return res
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/google/uuid"
+)
+
+// Prerequisites have to be other sessions starting before the one that needs
+// them, which also keeps them from forming a cycle.
+func (s *sessionService) checkPrerequisites(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	startAt time.Time,
+	prerequisiteIDs []uuid.UUID,
+) error {
+	for _, prerequisiteID := range prerequisiteIDs {
+		if prerequisiteID == sessionID {
+			return domain.ErrInvalidPrerequisite
+		}
+
+		prerequisite, err := s.repo.FindByID(ctx, prerequisiteID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrPrerequisiteNotFound
+			}
+
+			return err
+		}
+
+		if !prerequisite.StartAt.Before(startAt) {
+			return domain.ErrInvalidPrerequisite
+		}
+	}
+
+	return nil
+}
+
+// Keeps a session that moves to startAt after the prerequisites it already
+// has, as checkPrerequisites did when they were set.
+func (s *sessionService) checkCurrentPrerequisites(ctx context.Context, sessionID uuid.UUID, startAt time.Time) error {
+	prerequisites, err := s.repo.FindSessionPrerequisites(ctx, []uuid.UUID{sessionID})
+	if err != nil {
+		return err
+	}
+
+	for _, prerequisite := range prerequisites[sessionID] {
+		if !prerequisite.StartAt.Before(startAt) {
+			return domain.ErrInvalidPrerequisite
+		}
+	}
+
+	return nil
+}
+
+// Keeps a session that moves to startAt before the sessions that require it.
+func (s *sessionService) checkDependentSessions(ctx context.Context, sessionID uuid.UUID, startAt time.Time) error {
+	dependents, err := s.repo.FindDependentSessions(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	for _, dependent := range dependents {
+		if !startAt.Before(dependent.StartAt) {
+			return domain.ErrPrerequisiteStartsLater
+		}
+	}
+
+	return nil
+}
+
+// Refuses users who hold no registration for a prerequisite, naming every
+// session they are missing.
+func (s *sessionService) checkPrerequisitesMet(ctx context.Context, sessionID, userID uuid.UUID) error {
+	missing, err := s.repo.FindMissingPrerequisites(ctx, sessionID, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	titles := make([]string, 0, len(missing))
+	for _, session := range missing {
+		titles = append(titles, session.Title)
+	}
+
+	return domain.NewPrerequisitesNotMetError(titles)
+}
+
+// Keyed by session, so a page of sessions loads its prerequisites at once.
+func (s *sessionService) findPrerequisiteResponses(
+	ctx context.Context,
+	sessionIDs []uuid.UUID,
+) (map[uuid.UUID][]dto.SessionPrerequisiteResponse, error) {
+	prerequisites, err := s.repo.FindSessionPrerequisites(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	prerequisitesResponse := map[uuid.UUID][]dto.SessionPrerequisiteResponse{}
+	for sessionID, sessionPrerequisites := range prerequisites {
+		for _, prerequisite := range sessionPrerequisites {
+			prerequisitesResponse[sessionID] = append(prerequisitesResponse[sessionID], dto.SessionPrerequisiteResponse{
+				ID:      prerequisite.ID,
+				Title:   prerequisite.Title,
+				StartAt: prerequisite.StartAt.UTC(),
+			})
+		}
+	}
+
+	return prerequisitesResponse, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+	"github.com/google/uuid"
+)
+
+// The registrations a user holds across the sessions of an event, counted
+// per tag and per day in the event's time zone.
+type registrationUsage struct {
+	total int
+	tags  map[string]int
+	days  map[string]int
+}
+
+func newRegistrationUsage(sessions []entity.Session, timeZone string) registrationUsage {
+	usage := registrationUsage{
+		total: len(sessions),
+		tags:  map[string]int{},
+		days:  map[string]int{},
+	}
+
+	for _, session := range sessions {
+		for _, tag := range session.TagsArray() {
+			usage.tags[tag]++
+		}
+
+		usage.days[registrationDay(session.StartAt, timeZone)]++
+	}
+
+	return usage
+}
+
+func registrationDay(t time.Time, timeZone string) string {
+	return t.In(timezone.Location(timeZone)).Format(time.DateOnly)
+}
+
+func (s *sessionService) GetRegistrationQuotas(
+	ctx context.Context,
+	query dto.GetRegistrationQuotasQuery,
+) (dto.GetRegistrationQuotasResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetRegistrationQuotasResponse{}, valErr
+	}
+
+	event, err := s.eventRepo.FindByID(ctx, query.EventID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetRegistrationQuotasResponse{}, domain.ErrEventNotFound
+		}
+
+		return dto.GetRegistrationQuotasResponse{}, err
+	}
+
+	held, err := s.repo.FindHeldRegistrations(ctx, event.ID, query.UserID)
+	if err != nil {
+		return dto.GetRegistrationQuotasResponse{}, err
+	}
+
+	usage := newRegistrationUsage(held, event.TimeZone)
+	quotas := []dto.RegistrationQuotaResponse{}
+
+	if event.MaxRegistrations.Valid {
+		quotas = append(quotas, dto.RegistrationQuotaResponse{
+			Quota: "total",
+			Used:  usage.total,
+			Limit: int(event.MaxRegistrations.Int32),
+		})
+	}
+
+	if event.MaxRegistrationsPerTag.Valid {
+		for i := range len(enums.ShortSessionTag) {
+			tag := enums.ShortSessionTag[i]
+			quotas = append(quotas, dto.RegistrationQuotaResponse{
+				Quota: "tag",
+				Key:   tag,
+				Used:  usage.tags[tag],
+				Limit: int(event.MaxRegistrationsPerTag.Int32),
+			})
+		}
+	}
+
+	if event.MaxRegistrationsPerDay.Valid {
+		// every day of the event, plus days of sessions scheduled outside it
+		days := []string{}
+		for day := event.StartAt; !day.After(event.EndAt); day = day.AddDate(0, 0, 1) {
+			days = append(days, registrationDay(day, event.TimeZone))
+		}
+
+		days = append(days, registrationDay(event.EndAt, event.TimeZone))
+
+		for day := range usage.days {
+			days = append(days, day)
+		}
+
+		slices.Sort(days)
+
+		for _, day := range slices.Compact(days) {
+			quotas = append(quotas, dto.RegistrationQuotaResponse{
+				Quota: "day",
+				Key:   day,
+				Used:  usage.days[day],
+				Limit: int(event.MaxRegistrationsPerDay.Int32),
+			})
+		}
+	}
+
+	res := dto.GetRegistrationQuotasResponse{
+		EventID: event.ID,
+		Quotas:  quotas,
+	}
+
+	return res, nil
+}
+
+// Runs inside the registration or approval transaction. The user's
+// registrations stay locked until it commits, so the count can't change before
+// the new seat is taken. A pending request for the session itself doesn't
+// count, it is the one being decided.
+func (s *sessionService) checkRegistrationQuotas(
+	ctx context.Context,
+	event *entity.Event,
+	session *entity.Session,
+	userID uuid.UUID,
+) error {
+	if !event.MaxRegistrations.Valid && !event.MaxRegistrationsPerTag.Valid && !event.MaxRegistrationsPerDay.Valid {
+		return nil
+	}
+
+	err := s.repo.LockUserRegistrations(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	held, err := s.repo.FindHeldRegistrations(ctx, event.ID, userID)
+	if err != nil {
+		return err
+	}
+
+	held = slices.DeleteFunc(held, func(heldSession entity.Session) bool {
+		return heldSession.ID == session.ID
+	})
+
+	usage := newRegistrationUsage(held, event.TimeZone)
+
+	if event.MaxRegistrations.Valid && usage.total >= int(event.MaxRegistrations.Int32) {
+		return domain.NewRegistrationQuotaExceededError(int(event.MaxRegistrations.Int32), "in this event")
+	}
+
+	if event.MaxRegistrationsPerTag.Valid {
+		for _, tag := range session.TagsArray() {
+			if usage.tags[tag] >= int(event.MaxRegistrationsPerTag.Int32) {
+				return domain.NewRegistrationQuotaExceededError(
+					int(event.MaxRegistrationsPerTag.Int32),
+					fmt.Sprintf("tagged %s", tag),
+				)
+			}
+		}
+	}
+
+	if event.MaxRegistrationsPerDay.Valid {
+		day := registrationDay(session.StartAt, event.TimeZone)
+		if usage.days[day] >= int(event.MaxRegistrationsPerDay.Int32) {
+			return domain.NewRegistrationQuotaExceededError(
+				int(event.MaxRegistrationsPerDay.Int32),
+				fmt.Sprintf("on %s", day),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+// Holds the given registrations for every user of every event.
+type fakeQuotaRepository struct {
+	contracts.SessionRepository
+	held []entity.Session
+}
+
+func (f *fakeQuotaRepository) LockUserRegistrations(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeQuotaRepository) FindHeldRegistrations(_ context.Context, _, _ uuid.UUID) ([]entity.Session, error) {
+	return f.held, nil
+}
+
+func TestNewRegistrationUsage(t *testing.T) {
+	// 2025-05-01 18:00 UTC is already 2 May in Jakarta
+	evening := time.Date(2025, 5, 1, 18, 0, 0, 0, time.UTC)
+	morning := time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		sessions []entity.Session
+		timeZone string
+		want     registrationUsage
+	}{
+		{
+			name:     "no registrations",
+			timeZone: "Asia/Jakarta",
+			want:     registrationUsage{tags: map[string]int{}, days: map[string]int{}},
+		},
+		{
+			name: "tags and days",
+			sessions: []entity.Session{
+				{Tags: 32 | 16, StartAt: morning},
+				{Tags: 32, StartAt: evening},
+			},
+			timeZone: "Asia/Jakarta",
+			want: registrationUsage{
+				total: 2,
+				tags:  map[string]int{"PM": 2, "PD": 1},
+				days:  map[string]int{"2025-05-01": 1, "2025-05-02": 1},
+			},
+		},
+		{
+			name: "days in the event's time zone",
+			sessions: []entity.Session{
+				{StartAt: morning},
+				{StartAt: evening},
+			},
+			timeZone: "UTC",
+			want: registrationUsage{
+				total: 2,
+				tags:  map[string]int{},
+				days:  map[string]int{"2025-05-01": 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newRegistrationUsage(tt.sessions, tt.timeZone)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("usage = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRegistrationQuotas(t *testing.T) {
+	startAt := time.Date(2025, 5, 1, 2, 0, 0, 0, time.UTC)
+	session := &entity.Session{ID: uuid.New(), Tags: 32, StartAt: startAt}
+	other := entity.Session{ID: uuid.New(), Tags: 32, StartAt: startAt}
+
+	limit := sql.NullInt32{Int32: 1, Valid: true}
+
+	tests := []struct {
+		name           string
+		event          *entity.Event
+		held           []entity.Session
+		wantStatusCode int
+	}{
+		{
+			name:  "no quotas",
+			event: &entity.Event{},
+			held:  []entity.Session{other},
+		},
+		{
+			name:  "under every quota",
+			event: &entity.Event{MaxRegistrations: limit, MaxRegistrationsPerTag: limit, MaxRegistrationsPerDay: limit},
+		},
+		{
+			name:           "total reached",
+			event:          &entity.Event{MaxRegistrations: limit},
+			held:           []entity.Session{other},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "tag reached",
+			event:          &entity.Event{MaxRegistrationsPerTag: limit},
+			held:           []entity.Session{other},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:  "other tag",
+			event: &entity.Event{MaxRegistrationsPerTag: limit},
+			held:  []entity.Session{{ID: uuid.New(), Tags: 16, StartAt: startAt}},
+		},
+		{
+			name:           "day reached",
+			event:          &entity.Event{MaxRegistrationsPerDay: limit, TimeZone: "Asia/Jakarta"},
+			held:           []entity.Session{other},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:  "other day",
+			event: &entity.Event{MaxRegistrationsPerDay: limit, TimeZone: "Asia/Jakarta"},
+			held:  []entity.Session{{ID: uuid.New(), StartAt: startAt.AddDate(0, 0, 1)}},
+		},
+		{
+			name:  "pending request for the session being decided",
+			event: &entity.Event{MaxRegistrations: limit, MaxRegistrationsPerTag: limit, MaxRegistrationsPerDay: limit},
+			held:  []entity.Session{*session},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sessionService{repo: &fakeQuotaRepository{held: tt.held}}
+
+			err := s.checkRegistrationQuotas(context.Background(), tt.event, session, uuid.New())
+			if tt.wantStatusCode == 0 {
+				if err != nil {
+					t.Fatalf("err = %v, want none", err)
+				}
+
+				return
+			}
+
+			reqErr, ok := err.(*domain.RequestError)
+			if !ok {
+				t.Fatalf("err = %v, want a request error", err)
+			}
+
+			if reqErr.StatusCode != tt.wantStatusCode {
+				t.Errorf("status code = %d, want %d", reqErr.StatusCode, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
+)
+
+const (
+	// upcoming sessions considered before ranking, soonest first
+	recommendationCandidates = 200
+
+	// average of the survey scale answers, normalized to 0-1
+	highRating = 0.8
+
+	interestWeight   = 3.0 // per declared interest the session is tagged with
+	ratedTagWeight   = 2.0 // per highly rated session attended with a shared tag
+	maxRatedPerTag   = 3   // so one heavily attended tag doesn't drown the rest
+	coAttendeeWeight = 0.5 // per registrant who attended another session with the user
+	maxCoAttendees   = 10
+	seatsWeight      = 1.0 // times the share of seats still free
+)
+
+func (s *sessionService) GetRecommendations(
+	ctx context.Context,
+	query dto.GetRecommendationsQuery,
+) (dto.GetRecommendationsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetRecommendationsResponse{}, valErr
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	user, err := s.userRepo.FindByID(ctx, query.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetRecommendationsResponse{}, domain.ErrUserNotFound
+		}
+
+		return dto.GetRecommendationsResponse{}, err
+	}
+
+	now := time.Now()
+
+	ratedSessions, err := s.repo.FindHighlyRated(ctx, query.UserID, now, highRating)
+	if err != nil {
+		return dto.GetRecommendationsResponse{}, err
+	}
+
+	ratedTags := map[string]int{}
+	for _, session := range ratedSessions {
+		for _, tag := range session.TagsArray() {
+			ratedTags[tag]++
+		}
+	}
+
+	candidates, err := s.repo.FindCandidates(ctx, query.UserID, now, recommendationCandidates)
+	if err != nil {
+		return dto.GetRecommendationsResponse{}, err
+	}
+
+	interests := user.InterestsArray()
+	recommendations := make([]dto.RecommendationResponse, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		score, reason := scoreRecommendation(candidate, interests, ratedTags)
+		timeZone := cmp.Or(user.TimeZone.String, candidate.TimeZone)
+
+		recommendations = append(recommendations, dto.RecommendationResponse{
+			Session: dto.SessionResponse{
+				ID:               candidate.ID,
+				Title:            candidate.Title,
+				Description:      candidate.Description.String,
+				Type:             candidate.Type,
+				Tags:             candidate.TagsArray(),
+				StartAt:          candidate.StartAt.UTC(),
+				EndAt:            candidate.EndAt.UTC(),
+				TimeZone:         timeZone,
+				StartAtLocal:     timezone.WallTime(candidate.StartAt, timeZone),
+				EndAtLocal:       timezone.WallTime(candidate.EndAt, timeZone),
+				Room:             candidate.Room.String,
+				MeetingURL:       candidate.MeetingURL.String,
+				Capacity:         candidate.Capacity,
+				RequiresApproval: candidate.RequiresApproval,
+				ImageURI:         candidate.ImageURI.String,
+				Status:           candidate.Status,
+				Visibility:       candidate.Visibility,
+				Proposer: dto.UserResponse{
+					ID:   candidate.Proposer.ID,
+					Name: candidate.Proposer.Name,
+				},
+				CountAttendees: candidate.CountAttendees,
+				EventID:        candidate.EventID,
+			},
+			Score:  math.Round(score*100) / 100,
+			Reason: reason,
+		})
+	}
+
+	// candidates come soonest first, so ties keep the earlier session on top
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+
+	if len(recommendations) > query.Limit {
+		recommendations = recommendations[:query.Limit]
+	}
+
+	res := dto.GetRecommendationsResponse{
+		Recommendations: recommendations,
+	}
+
+	return res, nil
+}
+
+// Scores a candidate and explains it with the signal that contributed the most.
+func scoreRecommendation(
+	candidate entity.SessionCandidate,
+	interests []string,
+	ratedTags map[string]int,
+) (float64, string) {
+	var interestScore, ratedScore float64
+
+	matchedInterests := []string{}
+	ratedTag, ratedCount := "", 0
+
+	for _, tag := range candidate.TagsArray() {
+		if slices.Contains(interests, tag) {
+			interestScore += interestWeight
+			matchedInterests = append(matchedInterests, sessionTagLabel(tag))
+		}
+
+		count := min(ratedTags[tag], maxRatedPerTag)
+		ratedScore += float64(count) * ratedTagWeight
+
+		if ratedTags[tag] > ratedCount {
+			ratedTag, ratedCount = tag, ratedTags[tag]
+		}
+	}
+
+	coAttendeeScore := float64(min(candidate.CoAttendees, maxCoAttendees)) * coAttendeeWeight
+
+	freeSeats := int64(candidate.Capacity) - candidate.CountAttendees
+	seatsScore := float64(freeSeats) / float64(candidate.Capacity) * seatsWeight
+
+	score := interestScore + ratedScore + coAttendeeScore + seatsScore
+
+	reason := fmt.Sprintf("because it still has %d %s left", freeSeats, plural(freeSeats, "seat", "seats"))
+	best := seatsScore
+
+	if coAttendeeScore > best {
+		best = coAttendeeScore
+		reason = fmt.Sprintf(
+			"because %d %s who attended sessions with you registered",
+			candidate.CoAttendees,
+			plural(candidate.CoAttendees, "person", "people"),
+		)
+	}
+
+	if interestScore > best {
+		best = interestScore
+		reason = fmt.Sprintf("because you are interested in %s", strings.Join(matchedInterests, " and "))
+	}
+
+	if ratedScore > best {
+		reason = fmt.Sprintf(
+			"because you attended %d %s %s",
+			ratedCount,
+			sessionTagLabel(ratedTag),
+			plural(int64(ratedCount), "session", "sessions"),
+		)
+	}
+
+	return score, reason
+}
+
+func sessionTagLabel(tag string) string {
+	for i, shortTag := range enums.ShortSessionTag {
+		if shortTag == tag {
+			return enums.SessionTag[i]
+		}
+	}
+
+	return tag
+}
+
+func plural(count int64, singular string, plural string) string {
+	if count == 1 {
+		return singular
+	}
+
+	return plural
+}
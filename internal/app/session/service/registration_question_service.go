@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+// Answers point at the questions and options they were given for, so a form
+// that has been answered can no longer be replaced.
+func (s *sessionService) UpdateRegistrationQuestions(
+	ctx context.Context,
+	query dto.UpdateRegistrationQuestionsQuery,
+	req dto.UpdateRegistrationQuestionsRequest,
+) (dto.UpdateRegistrationQuestionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.UpdateRegistrationQuestionsResponse{}, valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return dto.UpdateRegistrationQuestionsResponse{}, valErr
+	}
+
+	_, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.UpdateRegistrationQuestionsResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.UpdateRegistrationQuestionsResponse{}, err
+	}
+
+	questions := []entity.RegistrationQuestion{}
+	prompts := map[string]bool{}
+	for position, questionReq := range req.Questions {
+		// prompts name the answer columns of the attendee export
+		prompt := strings.TrimSpace(questionReq.Prompt)
+		if prompts[prompt] || slices.Contains(sessionAttendeeExportColumns, prompt) {
+			return dto.UpdateRegistrationQuestionsResponse{}, domain.ErrInvalidRegistrationQuestion
+		}
+
+		prompts[prompt] = true
+		questionReq.Prompt = prompt
+
+		question, err := s.newRegistrationQuestion(query.ID, position, questionReq)
+		if err != nil {
+			return dto.UpdateRegistrationQuestionsResponse{}, err
+		}
+
+		questions = append(questions, question)
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.LockSession(ctx, query.ID)
+		if err != nil {
+			return err
+		}
+
+		countAnswers, err := s.repo.CountRegistrationAnswers(ctx, query.ID)
+		if err != nil {
+			return err
+		}
+
+		if countAnswers > 0 {
+			return domain.ErrRegistrationQuestionsAnswered
+		}
+
+		return s.repo.ReplaceRegistrationQuestions(ctx, query.ID, questions)
+	})
+	if err != nil {
+		return dto.UpdateRegistrationQuestionsResponse{}, err
+	}
+
+	res := dto.UpdateRegistrationQuestionsResponse{
+		RegistrationQuestions: toRegistrationQuestionResponses(questions),
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) newRegistrationQuestion(
+	sessionID uuid.UUID,
+	position int,
+	req dto.RegistrationQuestionRequest,
+) (entity.RegistrationQuestion, error) {
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return entity.RegistrationQuestion{}, err
+	}
+
+	question := entity.RegistrationQuestion{
+		ID:        id,
+		SessionID: sessionID,
+		Position:  position,
+		Type:      req.Type,
+		Prompt:    req.Prompt,
+		Required:  req.Required,
+	}
+
+	switch req.Type {
+	case 1: // text
+		if len(req.Options) != 0 {
+			return entity.RegistrationQuestion{}, domain.ErrInvalidRegistrationQuestion
+		}
+	case 2, 3: // select, checkbox
+		// a single checkbox is fine, e.g. to accept a code of conduct
+		if len(req.Options) == 0 || (req.Type == 2 && len(req.Options) < 2) {
+			return entity.RegistrationQuestion{}, domain.ErrInvalidRegistrationQuestion
+		}
+
+		for optionPosition, label := range req.Options {
+			optionID, err := s.uuidPkg.NewV7()
+			if err != nil {
+				return entity.RegistrationQuestion{}, err
+			}
+
+			question.Options = append(question.Options, entity.RegistrationQuestionOption{
+				ID:         optionID,
+				QuestionID: id,
+				Position:   optionPosition,
+				Label:      label,
+			})
+		}
+	}
+
+	return question, nil
+}
+
+// Checks the answers against the session's form. A select takes exactly one
+// option, a checkbox any number of them, and a required checkbox at least one.
+func (s *sessionService) newRegistrationAnswers(
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	questions []entity.RegistrationQuestion,
+	reqs []dto.RegistrationAnswerRequest,
+) ([]entity.RegistrationAnswer, error) {
+	answered := map[uuid.UUID]bool{}
+	answers := []entity.RegistrationAnswer{}
+
+	for _, req := range reqs {
+		var question *entity.RegistrationQuestion
+		for i := range questions {
+			if questions[i].ID == req.QuestionID {
+				question = &questions[i]
+			}
+		}
+
+		if question == nil || answered[question.ID] {
+			return nil, domain.ErrInvalidRegistrationAnswer
+		}
+
+		answer := entity.RegistrationAnswer{
+			SessionID:  sessionID,
+			UserID:     userID,
+			QuestionID: question.ID,
+		}
+
+		switch question.Type {
+		case 1: // text
+			text := strings.TrimSpace(req.Text)
+			if text == "" || len(req.OptionIDs) != 0 {
+				return nil, domain.ErrInvalidRegistrationAnswer
+			}
+
+			id, err := s.uuidPkg.NewV7()
+			if err != nil {
+				return nil, err
+			}
+
+			answer.ID = id
+			answer.TextValue = sql.NullString{String: text, Valid: true}
+			answers = append(answers, answer)
+		case 2, 3: // select, checkbox
+			if req.Text != "" || (question.Type == 2 && len(req.OptionIDs) != 1) {
+				return nil, domain.ErrInvalidRegistrationAnswer
+			}
+
+			chosen := map[uuid.UUID]bool{}
+			for _, optionID := range req.OptionIDs {
+				if chosen[optionID] || !hasRegistrationOption(question, optionID) {
+					return nil, domain.ErrInvalidRegistrationAnswer
+				}
+
+				chosen[optionID] = true
+
+				id, err := s.uuidPkg.NewV7()
+				if err != nil {
+					return nil, err
+				}
+
+				optionAnswer := answer
+				optionAnswer.ID = id
+				optionAnswer.OptionID = uuid.NullUUID{UUID: optionID, Valid: true}
+				answers = append(answers, optionAnswer)
+			}
+
+			// leaving every checkbox unticked is the same as not answering
+			if len(req.OptionIDs) == 0 {
+				continue
+			}
+		}
+
+		answered[question.ID] = true
+	}
+
+	for _, question := range questions {
+		if question.Required && !answered[question.ID] {
+			return nil, domain.ErrRegistrationQuestionUnanswered
+		}
+	}
+
+	return answers, nil
+}
+
+func hasRegistrationOption(question *entity.RegistrationQuestion, optionID uuid.UUID) bool {
+	for _, option := range question.Options {
+		if option.ID == optionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toRegistrationQuestionResponses(questions []entity.RegistrationQuestion) []dto.RegistrationQuestionResponse {
+	questionsResponse := []dto.RegistrationQuestionResponse{}
+	for _, question := range questions {
+		questionResponse := dto.RegistrationQuestionResponse{
+			ID:       question.ID,
+			Type:     question.Type,
+			Prompt:   question.Prompt,
+			Required: question.Required,
+		}
+
+		for _, option := range question.Options {
+			questionResponse.Options = append(questionResponse.Options, dto.RegistrationQuestionOptionResponse{
+				ID:    option.ID,
+				Label: option.Label,
+			})
+		}
+
+		questionsResponse = append(questionsResponse, questionResponse)
+	}
+
+	return questionsResponse
+}
+
+// Groups the answers of a session by user, one entry per answered question in
+// the order of the form.
+func toRegistrationAnswerResponses(
+	questions []entity.RegistrationQuestion,
+	answers []entity.RegistrationAnswer,
+) map[uuid.UUID][]dto.RegistrationAnswerResponse {
+	answersByUser := map[uuid.UUID][]entity.RegistrationAnswer{}
+	for _, answer := range answers {
+		answersByUser[answer.UserID] = append(answersByUser[answer.UserID], answer)
+	}
+
+	res := map[uuid.UUID][]dto.RegistrationAnswerResponse{}
+	for userID, userAnswers := range answersByUser {
+		for _, question := range questions {
+			answerResponse := dto.RegistrationAnswerResponse{
+				QuestionID: question.ID,
+				Prompt:     question.Prompt,
+			}
+
+			answered := false
+			for _, answer := range userAnswers {
+				if answer.QuestionID != question.ID {
+					continue
+				}
+
+				answered = true
+				answerResponse.Text = answer.TextValue.String
+
+				for _, option := range question.Options {
+					if answer.OptionID.Valid && option.ID == answer.OptionID.UUID {
+						answerResponse.Options = append(answerResponse.Options, option.Label)
+					}
+				}
+			}
+
+			if answered {
+				res[userID] = append(res[userID], answerResponse)
+			}
+		}
+	}
+
+	return res
+}
+
+// Loads the answers of a session keyed by user, or nothing when the session
+// has no form.
+func (s *sessionService) findRegistrationAnswers(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]entity.RegistrationQuestion, map[uuid.UUID][]dto.RegistrationAnswerResponse, error) {
+	questions, err := s.repo.FindRegistrationQuestions(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(questions) == 0 {
+		return questions, map[uuid.UUID][]dto.RegistrationAnswerResponse{}, nil
+	}
+
+	answers, err := s.repo.FindRegistrationAnswers(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return questions, toRegistrationAnswerResponses(questions, answers), nil
+}
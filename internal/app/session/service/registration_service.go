@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/google/uuid"
+)
+
+func (s *sessionService) GetRegistrationRequests(
+	ctx context.Context,
+	query dto.GetRegistrationRequestsQuery,
+) (dto.GetRegistrationRequestsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetRegistrationRequestsResponse{}, valErr
+	}
+
+	if query.Status == 0 {
+		query.Status = 1 // pending
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	requests, err := s.repo.FindRegistrationRequests(
+		ctx,
+		query.ID,
+		query.Status,
+		query.Limit,
+		(query.Page-1)*query.Limit,
+	)
+	if err != nil {
+		return dto.GetRegistrationRequestsResponse{}, err
+	}
+
+	totalData, err := s.repo.CountRegistrationRequests(ctx, query.ID, query.Status)
+	if err != nil {
+		return dto.GetRegistrationRequestsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	_, answers, err := s.findRegistrationAnswers(ctx, query.ID)
+	if err != nil {
+		return dto.GetRegistrationRequestsResponse{}, err
+	}
+
+	requestsResponse := []dto.RegistrationRequestResponse{}
+	for _, request := range requests {
+		requestResponse := dto.RegistrationRequestResponse{
+			UserID:    request.UserID,
+			Status:    request.Status,
+			Reason:    request.Reason.String,
+			CreatedAt: request.CreatedAt,
+			User: dto.UserResponse{
+				ID:    request.User.ID,
+				Name:  request.User.Name,
+				Email: request.User.Email,
+				Role:  request.User.Role,
+			},
+			Answers: answers[request.UserID],
+		}
+
+		if request.DecidedAt.Valid {
+			requestResponse.DecidedAt = &request.DecidedAt.Time
+		}
+
+		requestsResponse = append(requestsResponse, requestResponse)
+	}
+
+	res := dto.GetRegistrationRequestsResponse{
+		RegistrationRequests: requestsResponse,
+		Meta: dto.PaginationResponse{
+			TotalData: &totalData,
+			TotalPage: &totalPage,
+			Page:      query.Page,
+			Limit:     query.Limit,
+		},
+	}
+
+	return res, nil
+}
+
+// Approves pending requests in the order given. Each approval takes a seat, so
+// capacity, the applicant's time conflicts and the event's registration quotas
+// are checked again with the session locked against concurrent approvals.
+func (s *sessionService) ApproveRegistrations(
+	ctx context.Context,
+	query dto.DecideRegistrationsQuery,
+	req dto.ApproveRegistrationsRequest,
+) (dto.BulkSessionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	session, err := s.findDecidableSession(ctx, query.ID)
+	if err != nil {
+		return dto.BulkSessionsResponse{}, err
+	}
+
+	var event *entity.Event
+	if session.EventID.Valid {
+		event, err = s.eventRepo.FindByID(ctx, session.EventID.UUID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return dto.BulkSessionsResponse{}, domain.ErrEventNotFound
+			}
+
+			return dto.BulkSessionsResponse{}, err
+		}
+	}
+
+	res, approved := runBulk(
+		ctx,
+		s.repo,
+		req.BulkSessionsRequest,
+		s.findPendingRegistrationRequest(session),
+		func(ctx context.Context, request *entity.RegistrationRequest) error {
+			err := s.repo.LockSession(ctx, session.ID)
+			if err != nil {
+				return err
+			}
+
+			countSessionAttendees, err := s.repo.CountAttendees(
+				ctx,
+				session.ID,
+				uuid.Nil,
+				session.EndAt,
+				session.StartAt,
+				false,
+			)
+			if err != nil {
+				return err
+			}
+
+			if int(countSessionAttendees) >= session.Capacity {
+				return domain.ErrSessionFull
+			}
+
+			countUserSessionTimeConflict, err := s.repo.CountAttendees(
+				ctx,
+				uuid.Nil,
+				request.UserID,
+				session.EndAt,
+				session.StartAt,
+				false,
+			)
+			if err != nil {
+				return err
+			}
+
+			if countUserSessionTimeConflict > 0 {
+				return domain.ErrSessionTimeConflict
+			}
+
+			// quotas may have been lowered, or filled by other sessions, since the request was made
+			if event != nil {
+				err = s.checkRegistrationQuotas(ctx, event, session, request.UserID)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = s.repo.CreateSessionAttendee(ctx, &entity.SessionAttendee{
+				SessionID: session.ID,
+				UserID:    request.UserID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return s.decideRegistrationRequest(
+				ctx,
+				session,
+				request,
+				req.DeciderID,
+				2, // approved
+				"",
+				4, // registration approved
+				"Registration approved",
+				fmt.Sprintf("Your registration for %s has been approved. See you there!", session.Title),
+			)
+		},
+	)
+
+	if len(approved) > 0 {
+		s.publishSessionSeats(ctx, session)
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) DeclineRegistrations(
+	ctx context.Context,
+	query dto.DecideRegistrationsQuery,
+	req dto.DeclineRegistrationsRequest,
+) (dto.BulkSessionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return dto.BulkSessionsResponse{}, valErr
+	}
+
+	session, err := s.findDecidableSession(ctx, query.ID)
+	if err != nil {
+		return dto.BulkSessionsResponse{}, err
+	}
+
+	body := fmt.Sprintf("Your registration for %s has been declined.", session.Title)
+	if req.Reason != "" {
+		body = fmt.Sprintf("Your registration for %s has been declined: %s.", session.Title, req.Reason)
+	}
+
+	res, _ := runBulk(
+		ctx,
+		s.repo,
+		req.BulkSessionsRequest,
+		s.findPendingRegistrationRequest(session),
+		func(ctx context.Context, request *entity.RegistrationRequest) error {
+			return s.decideRegistrationRequest(
+				ctx,
+				session,
+				request,
+				req.DeciderID,
+				3, // declined
+				req.Reason,
+				5, // registration declined
+				"Registration declined",
+				body,
+			)
+		},
+	)
+
+	return res, nil
+}
+
+// Requests can only be decided while the session is approved and has not
+// started yet.
+func (s *sessionService) findDecidableSession(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	session, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	if session.Status == 4 {
+		return nil, domain.ErrSessionIsCancelled
+	}
+
+	if session.Status != 2 {
+		return nil, domain.ErrSessionNotAccepted
+	}
+
+	if session.StartAt.Before(time.Now()) {
+		return nil, domain.ErrSessionAlreadyStarted
+	}
+
+	return session, nil
+}
+
+func (s *sessionService) findPendingRegistrationRequest(
+	session *entity.Session,
+) func(ctx context.Context, userID uuid.UUID) (*entity.RegistrationRequest, error) {
+	return func(ctx context.Context, userID uuid.UUID) (*entity.RegistrationRequest, error) {
+		request, err := s.repo.FindRegistrationRequest(ctx, session.ID, userID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, domain.ErrRegistrationRequestNotFound
+			}
+
+			return nil, err
+		}
+
+		if request.Status != 1 { // pending
+			return nil, domain.ErrRegistrationRequestDecided
+		}
+
+		return request, nil
+	}
+}
+
+// Records the outcome on the request and tells the applicant about it.
+func (s *sessionService) decideRegistrationRequest(
+	ctx context.Context,
+	session *entity.Session,
+	request *entity.RegistrationRequest,
+	deciderID uuid.UUID,
+	status int16,
+	reason string,
+	notificationType int16,
+	title string,
+	body string,
+) error {
+	request.Status = status
+	request.Reason = sql.NullString{String: reason, Valid: reason != ""}
+	request.DecidedBy = uuid.NullUUID{UUID: deciderID, Valid: deciderID != uuid.Nil}
+	request.DecidedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	err := s.repo.UpdateRegistrationRequest(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return s.notifyUsers(ctx, session, []uuid.UUID{request.UserID}, notificationType, title, body)
+}
+
+// Lets an applicant take back a request that has not been decided yet.
+func (s *sessionService) withdrawRegistrationRequest(ctx context.Context, sessionID, userID uuid.UUID) error {
+	request, err := s.repo.FindRegistrationRequest(ctx, sessionID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotRegistered
+		}
+
+		return err
+	}
+
+	if request.Status != 1 { // pending
+		return domain.ErrSessionNotRegistered
+	}
+
+	return s.repo.DeleteRegistrationRequest(ctx, sessionID, userID)
+}
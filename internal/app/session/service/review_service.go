@@ -0,0 +1,444 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/google/uuid"
+)
+
+func (s *sessionService) EditReviewSession(
+	ctx context.Context,
+	query dto.EditReviewSessionQuery,
+	req dto.EditReviewSessionRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, err := s.repo.FindByID(ctx, query.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	if time.Now().After(session.EndAt.Add(env.AppEnv.ReviewEditWindow)) {
+		return domain.ErrReviewEditWindowClosed
+	}
+
+	sessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, req.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotRegistered
+		}
+
+		return err
+	}
+
+	if sessionAttendee.DeletedReason.Valid {
+		return domain.ErrReviewDeleted
+	}
+
+	if !sessionAttendee.Review.Valid {
+		return domain.ErrSessionNotReviewed
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	revision := entity.ReviewRevision{
+		ID:        id,
+		SessionID: sessionAttendee.SessionID,
+		UserID:    sessionAttendee.UserID,
+		Review:    sessionAttendee.Review.String,
+	}
+
+	sessionAttendee.Review = sql.NullString{String: req.Review, Valid: true}
+	sessionAttendee.ReviewEditedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	// an edit never lands without the revision it replaces
+	return s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.CreateReviewRevision(ctx, &revision)
+		if err != nil {
+			return err
+		}
+
+		return s.repo.UpdateSessionAttendee(ctx, sessionAttendee)
+	})
+}
+
+func (s *sessionService) GetReviewRevisions(
+	ctx context.Context,
+	query dto.GetReviewRevisionsQuery,
+) (dto.GetReviewRevisionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetReviewRevisionsResponse{}, valErr
+	}
+
+	sessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetReviewRevisionsResponse{}, domain.ErrReviewNotFound
+		}
+
+		return dto.GetReviewRevisionsResponse{}, err
+	}
+
+	if !sessionAttendee.Review.Valid {
+		return dto.GetReviewRevisionsResponse{}, domain.ErrReviewNotFound
+	}
+
+	revisions, err := s.repo.FindReviewRevisions(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		return dto.GetReviewRevisionsResponse{}, err
+	}
+
+	revisionsResponse := []dto.ReviewRevisionResponse{}
+	for _, revision := range revisions {
+		revisionsResponse = append(revisionsResponse, dto.ReviewRevisionResponse{
+			ID:        revision.ID,
+			Review:    revision.Review,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	res := dto.GetReviewRevisionsResponse{
+		Review:    sessionAttendee.Review.String,
+		Revisions: revisionsResponse,
+	}
+
+	if sessionAttendee.ReviewEditedAt.Valid {
+		res.EditedAt = &sessionAttendee.ReviewEditedAt.Time
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) ReportReview(
+	ctx context.Context,
+	query dto.ReportReviewQuery,
+	req dto.ReportReviewRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	if query.UserID == req.ReporterID {
+		return domain.ErrCantReportOwnReview
+	}
+
+	_, err := s.repo.FindByID(ctx, query.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	sessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrReviewNotFound
+		}
+
+		return err
+	}
+
+	if !sessionAttendee.Review.Valid {
+		return domain.ErrReviewNotFound
+	}
+
+	if sessionAttendee.DeletedReason.Valid {
+		return domain.ErrReviewDeleted
+	}
+
+	countReports, err := s.repo.CountReviewReports(ctx, query.SessionID, query.UserID, req.ReporterID)
+	if err != nil {
+		return err
+	}
+
+	if countReports > 0 {
+		return domain.ErrReviewAlreadyReported
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	report := entity.ReviewReport{
+		ID:         id,
+		SessionID:  query.SessionID,
+		UserID:     query.UserID,
+		ReporterID: req.ReporterID,
+		Reason:     req.Reason,
+	}
+
+	err = s.repo.CreateReviewReport(ctx, &report)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sessionService) GetReviewReports(
+	ctx context.Context,
+	query dto.GetReviewReportsQuery,
+) (dto.GetReviewReportsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetReviewReportsResponse{}, valErr
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	reviews, err := s.repo.FindFlaggedReviews(ctx, query.Limit, query.Limit*(query.Page-1))
+	if err != nil {
+		return dto.GetReviewReportsResponse{}, err
+	}
+
+	totalData, err := s.repo.CountFlaggedReviews(ctx)
+	if err != nil {
+		return dto.GetReviewReportsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	meta := dto.PaginationResponse{
+		TotalData: &totalData,
+		TotalPage: &totalPage,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	reviewsResponse := []dto.FlaggedReviewResponse{}
+	for _, review := range reviews {
+		reports, err := s.repo.FindReviewReports(ctx, review.SessionID, review.UserID)
+		if err != nil {
+			return dto.GetReviewReportsResponse{}, err
+		}
+
+		reportsResponse := []dto.ReviewReportResponse{}
+		for _, report := range reports {
+			reportsResponse = append(reportsResponse, dto.ReviewReportResponse{
+				ID:        report.ID,
+				Reason:    report.Reason,
+				CreatedAt: report.CreatedAt,
+				Reporter: dto.UserResponse{
+					ID:    report.Reporter.ID,
+					Name:  report.Reporter.Name,
+					Email: report.Reporter.Email,
+					Role:  report.Reporter.Role,
+				},
+			})
+		}
+
+		reviewsResponse = append(reviewsResponse, dto.FlaggedReviewResponse{
+			SessionID:    review.SessionID,
+			UserID:       review.UserID,
+			Review:       review.Review.String,
+			SessionTitle: review.Session.Title,
+			User: dto.UserResponse{
+				ID:    review.User.ID,
+				Name:  review.User.Name,
+				Email: review.User.Email,
+				Role:  review.User.Role,
+			},
+			CountReports: review.CountReports,
+			Reports:      reportsResponse,
+		})
+	}
+
+	res := dto.GetReviewReportsResponse{
+		Reviews: reviewsResponse,
+		Meta:    meta,
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) ModerateReview(
+	ctx context.Context,
+	query dto.ModerateReviewQuery,
+	req dto.ModerateReviewRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	_, err := s.repo.FindByID(ctx, query.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	sessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrReviewNotFound
+		}
+
+		return err
+	}
+
+	if !sessionAttendee.Review.Valid {
+		return domain.ErrReviewNotFound
+	}
+
+	switch req.Action {
+	case 1: // hide
+		if sessionAttendee.DeletedReason.Valid {
+			return domain.ErrReviewDeleted
+		}
+
+		sessionAttendee.DeletedReason = sql.NullString{String: req.Reason, Valid: true}
+	case 2: // restore
+		if !sessionAttendee.DeletedReason.Valid {
+			return domain.ErrReviewNotDeleted
+		}
+
+		sessionAttendee.DeletedReason = sql.NullString{}
+	case 3: // dismiss
+		countReports, err := s.repo.CountReviewReports(ctx, query.SessionID, query.UserID, uuid.Nil)
+		if err != nil {
+			return err
+		}
+
+		if countReports == 0 {
+			return domain.ErrReviewNotReported
+		}
+	}
+
+	// the review, its reports and the audit entry change together or not at all
+	return s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		if req.Action != 3 {
+			err := s.repo.UpdateSessionAttendee(ctx, sessionAttendee)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.recordReviewModeration(ctx, sessionAttendee, req.ModeratorID, req.Action, req.Reason)
+	})
+}
+
+func (s *sessionService) GetReviewModerationLogs(
+	ctx context.Context,
+	query dto.GetReviewModerationLogsQuery,
+) (dto.GetReviewModerationLogsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetReviewModerationLogsResponse{}, valErr
+	}
+
+	_, err := s.repo.FindSessionAttendee(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetReviewModerationLogsResponse{}, domain.ErrReviewNotFound
+		}
+
+		return dto.GetReviewModerationLogsResponse{}, err
+	}
+
+	logs, err := s.repo.FindReviewModerationLogs(ctx, query.SessionID, query.UserID)
+	if err != nil {
+		return dto.GetReviewModerationLogsResponse{}, err
+	}
+
+	logsResponse := []dto.ReviewModerationLogResponse{}
+	for _, moderationLog := range logs {
+		logsResponse = append(logsResponse, dto.ReviewModerationLogResponse{
+			ID:          moderationLog.ID,
+			ModeratorID: moderationLog.ModeratorID.UUID,
+			Action:      moderationLog.Action,
+			Reason:      moderationLog.Reason,
+			CreatedAt:   moderationLog.CreatedAt,
+		})
+	}
+
+	res := dto.GetReviewModerationLogsResponse{
+		Logs: logsResponse,
+	}
+
+	return res, nil
+}
+
+// Every moderation decision closes the open reports of the review and leaves an audit trail.
+// Callers run it in the transaction that changes the review.
+func (s *sessionService) recordReviewModeration(
+	ctx context.Context,
+	sessionAttendee *entity.SessionAttendee,
+	moderatorID uuid.UUID,
+	action int16,
+	reason string,
+) error {
+	err := s.repo.ResolveReviewReports(ctx, sessionAttendee.SessionID, sessionAttendee.UserID)
+	if err != nil {
+		return err
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	moderationLog := entity.ReviewModerationLog{
+		ID:          id,
+		SessionID:   sessionAttendee.SessionID,
+		UserID:      sessionAttendee.UserID,
+		ModeratorID: uuid.NullUUID{UUID: moderatorID, Valid: moderatorID != uuid.Nil},
+		Action:      action,
+		Reason:      reason,
+	}
+
+	err = s.repo.CreateReviewModerationLog(ctx, &moderationLog)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
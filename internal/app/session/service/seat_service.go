@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/pubsub"
+	"github.com/google/uuid"
+)
+
+func (s *sessionService) SubscribeSessionSeats(
+	ctx context.Context,
+	query dto.SubscribeSessionSeatsQuery,
+) (<-chan []byte, func(), error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, nil, valErr
+	}
+
+	if len(query.SessionIDs) == 0 && query.EventID == uuid.Nil {
+		return nil, nil, domain.ErrSeatStreamTargetRequired
+	}
+
+	topics := []string{}
+	for _, sessionID := range query.SessionIDs {
+		session, err := s.repo.FindByID(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, nil, domain.ErrSessionNotFound
+			}
+
+			return nil, nil, err
+		}
+
+		canView, err := s.canViewSession(ctx, session, query.ViewerID, query.ViewerRole, query.InviteCode)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// same as GetSession, invite-only sessions stay hidden rather than admitting they exist
+		if !canView {
+			return nil, nil, domain.ErrSessionNotFound
+		}
+
+		topics = append(topics, sessionSeatsTopic(sessionID))
+	}
+
+	if query.EventID != uuid.Nil {
+		err := s.checkEventExists(ctx, query.EventID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		topics = append(topics, eventSeatsTopic(query.EventID))
+	}
+
+	messages, unsubscribe := pubsub.SubscribeAll(s.pubsub, topics)
+
+	return messages, unsubscribe, nil
+}
+
+// For seats changed outside the session module, like the ones a deleted account
+// gives up. Sessions that can't be found anymore are skipped.
+func (s *sessionService) PublishSessionSeats(ctx context.Context, sessionIDs []uuid.UUID) {
+	for _, sessionID := range sessionIDs {
+		session, err := s.repo.FindByID(ctx, sessionID)
+		if err != nil {
+			continue
+		}
+
+		s.publishSessionSeats(ctx, session)
+	}
+}
+
+// Occupancy is published to the session and its event, a failure here must not fail the registration.
+func (s *sessionService) publishSessionSeats(ctx context.Context, session *entity.Session) {
+	countSessionAttendees, err := s.repo.CountAttendees(
+		ctx,
+		session.ID,
+		uuid.Nil,
+		time.Time{},
+		time.Time{},
+		false,
+	)
+	if err != nil {
+		return
+	}
+
+	availableSeats := int64(session.Capacity) - countSessionAttendees
+	if availableSeats < 0 {
+		availableSeats = 0
+	}
+
+	payload, err := json.Marshal(dto.SessionSeatsResponse{
+		SessionID:      session.ID,
+		EventID:        session.EventID,
+		Capacity:       session.Capacity,
+		CountAttendees: countSessionAttendees,
+		AvailableSeats: availableSeats,
+	})
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SessionService][publishSessionSeats]")
+
+		return
+	}
+
+	s.pubsub.Publish(sessionSeatsTopic(session.ID), payload)
+
+	// event subscribers aren't checked against each session, so invite-only ones stay off the event topic
+	if session.EventID.Valid && session.Visibility != 3 { // invite only
+		s.pubsub.Publish(eventSeatsTopic(session.EventID.UUID), payload)
+	}
+}
+
+func sessionSeatsTopic(sessionID uuid.UUID) string {
+	return fmt.Sprintf("sessions:%s:seats", sessionID)
+}
+
+func eventSeatsTopic(eventID uuid.UUID) string {
+	return fmt.Sprintf("events:%s:seats", eventID)
+}
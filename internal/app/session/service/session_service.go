@@ -1,26 +1,39 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/pubsub"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/spreadsheet"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
 	uuidPkg "github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
 	"github.com/google/uuid"
 )
 
 type sessionService struct {
-	repo      contracts.SessionRepository
-	validator validator.ValidatorInterface
-	uuidPkg   uuidPkg.CustomUUIDInterface
+	repo             contracts.SessionRepository
+	eventRepo        contracts.EventRepository
+	userRepo         contracts.UserRepository
+	notificationRepo contracts.NotificationRepository
+	pubsub           pubsub.CustomPubSubInterface
+	spreadsheet      spreadsheet.CustomSpreadsheetInterface
+	validator        validator.ValidatorInterface
+	uuidPkg          uuidPkg.CustomUUIDInterface
 }
 
 func (s *sessionService) AcceptSession(
@@ -42,6 +55,14 @@ func (s *sessionService) AcceptSession(
 		return err
 	}
 
+	return s.acceptSession(ctx, session, req)
+}
+
+func (s *sessionService) acceptSession(
+	ctx context.Context,
+	session *entity.Session,
+	req dto.AcceptSessionRequest,
+) error {
 	tagsBinary := "000000"
 	for _, tag := range req.Tags {
 		switch tag {
@@ -65,6 +86,8 @@ func (s *sessionService) AcceptSession(
 		return err
 	}
 
+	startAt := session.StartAt
+
 	if req.Title != "" {
 		session.Title = req.Title
 	}
@@ -101,6 +124,36 @@ func (s *sessionService) AcceptSession(
 		session.Capacity = req.Capacity
 	}
 
+	if req.Visibility != 0 {
+		session.Visibility = req.Visibility
+	}
+
+	if req.RequiresApproval != nil {
+		session.RequiresApproval = *req.RequiresApproval
+	}
+
+	if req.EventID != uuid.Nil {
+		timeZone, err := s.sessionTimeZone(ctx, req.EventID, "")
+		if err != nil {
+			return err
+		}
+
+		session.EventID = uuid.NullUUID{UUID: req.EventID, Valid: true}
+		session.TimeZone = timeZone
+	}
+
+	if !session.StartAt.Equal(startAt) {
+		err = s.checkCurrentPrerequisites(ctx, session.ID, session.StartAt)
+		if err != nil {
+			return err
+		}
+
+		err = s.checkDependentSessions(ctx, session.ID, session.StartAt)
+		if err != nil {
+			return err
+		}
+	}
+
 	session.Status = 2 // Accepted
 
 	log.Info(log.LogInfo{
@@ -130,6 +183,8 @@ func (s *sessionService) CreateSession(ctx context.Context, req dto.CreateSessio
 		time.Time{},
 		req.ProposerID,
 		1, // pending
+		uuid.Nil,
+		uuid.Nil,
 	)
 	if err != nil {
 		return err
@@ -139,6 +194,74 @@ func (s *sessionService) CreateSession(ctx context.Context, req dto.CreateSessio
 		return domain.ErrSessionProposalLimit
 	}
 
+	return s.createSession(ctx, req, 1) // pending
+}
+
+func (s *sessionService) CloneSession(
+	ctx context.Context,
+	query dto.CloneSessionQuery,
+	req dto.CloneSessionRequest,
+) error {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	source, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	createReq := dto.CreateSessionRequest{
+		ProposerID:       req.UserID,
+		Title:            source.Title,
+		Description:      source.Description.String,
+		Type:             source.Type,
+		Tags:             source.TagsArray(),
+		StartAt:          req.StartAt,
+		EndAt:            req.EndAt,
+		Room:             req.Room,
+		MeetingURL:       req.MeetingURL,
+		Capacity:         source.Capacity,
+		EventID:          req.EventID,
+		TimeZone:         source.TimeZone,
+		Visibility:       source.Visibility,
+		RequiresApproval: source.RequiresApproval,
+	}
+
+	if req.Role != 2 { // speakers clone into a new proposal
+		return s.CreateSession(ctx, createReq)
+	}
+
+	// event coordinator clones an accepted session straight into an event
+	if source.Status != 2 { // Approved
+		return domain.ErrSessionNotAccepted
+	}
+
+	if req.EventID == uuid.Nil {
+		return domain.ErrSessionCloneEventRequired
+	}
+
+	createReq.ProposerID = source.ProposerID
+
+	valErr = s.validator.Validate(createReq)
+	if valErr != nil {
+		return valErr
+	}
+
+	return s.createSession(ctx, createReq, 2) // approved
+}
+
+func (s *sessionService) createSession(ctx context.Context, req dto.CreateSessionRequest, status int16) error {
+	timeZone, err := s.sessionTimeZone(ctx, req.EventID, req.TimeZone)
+	if err != nil {
+		return err
+	}
+
 	id, err := s.uuidPkg.NewV7()
 	if err != nil {
 		return err
@@ -167,20 +290,41 @@ func (s *sessionService) CreateSession(ctx context.Context, req dto.CreateSessio
 	}
 
 	session := entity.Session{
-		ID:          id,
-		ProposerID:  req.ProposerID,
-		Title:       req.Title,
-		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
-		Type:        req.Type,
-		Tags:        int16(tagsNumber),
-		StartAt:     req.StartAt,
-		EndAt:       req.EndAt,
-		Room:        sql.NullString{String: req.Room, Valid: req.Room != ""},
-		MeetingURL:  sql.NullString{String: req.MeetingURL, Valid: req.MeetingURL != ""},
-		Capacity:    req.Capacity,
+		ID:               id,
+		ProposerID:       req.ProposerID,
+		Title:            req.Title,
+		Description:      sql.NullString{String: req.Description, Valid: req.Description != ""},
+		Type:             req.Type,
+		Tags:             int16(tagsNumber),
+		Status:           status,
+		StartAt:          req.StartAt,
+		EndAt:            req.EndAt,
+		Room:             sql.NullString{String: req.Room, Valid: req.Room != ""},
+		MeetingURL:       sql.NullString{String: req.MeetingURL, Valid: req.MeetingURL != ""},
+		Capacity:         req.Capacity,
+		EventID:          uuid.NullUUID{UUID: req.EventID, Valid: req.EventID != uuid.Nil},
+		TimeZone:         timeZone,
+		Visibility:       cmp.Or(req.Visibility, 1), // public
+		RequiresApproval: req.RequiresApproval,
+	}
+
+	err = s.checkPrerequisites(ctx, id, req.StartAt, req.PrerequisiteIDs)
+	if err != nil {
+		return err
 	}
 
-	err = s.repo.Create(ctx, &session)
+	err = s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.Create(ctx, &session)
+		if err != nil {
+			return err
+		}
+
+		if len(req.PrerequisiteIDs) == 0 {
+			return nil
+		}
+
+		return s.repo.ReplaceSessionPrerequisites(ctx, id, req.PrerequisiteIDs)
+	})
 	if err != nil {
 		return err
 	}
@@ -220,6 +364,114 @@ func (s *sessionService) DeleteSession(ctx context.Context, query dto.DeleteSess
 	return nil
 }
 
+func (s *sessionService) GetDeletedSessions(
+	ctx context.Context,
+	query dto.GetDeletedSessionsQuery,
+) (dto.GetSessionsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSessionsResponse{}, valErr
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	sessions, err := s.repo.FindAllDeleted(ctx, query.Limit, query.Limit*(query.Page-1))
+	if err != nil {
+		return dto.GetSessionsResponse{}, err
+	}
+
+	totalData, err := s.repo.CountDeleted(ctx)
+	if err != nil {
+		return dto.GetSessionsResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	meta := dto.PaginationResponse{
+		TotalData: &totalData,
+		TotalPage: &totalPage,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	sessionsResponse := []dto.SessionResponse{}
+	for _, session := range sessions {
+		timeZone := session.TimeZone
+		sessionResponse := dto.SessionResponse{
+			ID:               session.ID,
+			Title:            session.Title,
+			Description:      session.Description.String,
+			Type:             session.Type,
+			Tags:             session.TagsArray(),
+			StartAt:          session.StartAt.UTC(),
+			EndAt:            session.EndAt.UTC(),
+			TimeZone:         timeZone,
+			StartAtLocal:     timezone.WallTime(session.StartAt, timeZone),
+			EndAtLocal:       timezone.WallTime(session.EndAt, timeZone),
+			Room:             session.Room.String,
+			MeetingURL:       session.MeetingURL.String,
+			Capacity:         session.Capacity,
+			RequiresApproval: session.RequiresApproval,
+			ImageURI:         session.ImageURI.String,
+			Status:           session.Status,
+			Visibility:       session.Visibility,
+			Proposer: dto.UserResponse{
+				ID:    session.Proposer.ID,
+				Name:  session.Proposer.Name,
+				Email: session.Proposer.Email,
+			},
+			EventID:   session.EventID,
+			DeletedAt: &session.DeletedAt.Time,
+		}
+
+		if session.CancelledAt.Valid {
+			sessionResponse.CancelledAt = &session.CancelledAt.Time
+			sessionResponse.CancelledReason = session.CancelledReason.String
+		}
+
+		sessionsResponse = append(sessionsResponse, sessionResponse)
+	}
+
+	res := dto.GetSessionsResponse{
+		Sessions: sessionsResponse,
+		Meta:     meta,
+	}
+
+	return res, nil
+}
+
+func (s *sessionService) RestoreSession(ctx context.Context, query dto.RestoreSessionQuery) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, err := s.repo.FindDeletedByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	err = s.repo.Restore(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *sessionService) GetSessionAttendees(
 	ctx context.Context,
 	query dto.GetSessionAttendeesQuery,
@@ -245,55 +497,118 @@ func (s *sessionService) GetSessionAttendees(
 		query.SortOrder = "ASC"
 	}
 
+	desc := strings.EqualFold(query.SortOrder, "desc")
+
+	var after *cursor.Cursor
+	if query.Cursor != "" {
+		var err error
+		after, err = cursor.Decode(query.Cursor, query.SortBy, desc)
+		if err != nil {
+			return dto.GetSessionAttendeesResponse{}, domain.ErrInvalidCursor
+		}
+	}
+
+	offset := query.Limit * (query.Page - 1)
+	if after != nil {
+		offset = 0
+	}
+
 	sessionAttendees, err := s.repo.FindSessionAttendees(
 		ctx,
 		query.ID,
 		uuid.Nil,
-		query.Limit,
-		query.Limit*(query.Page-1),
+		query.Limit+1, // the extra row tells whether there is a next page
+		offset,
 		query.SortBy,
 		query.SortOrder,
+		after,
 	)
 	if err != nil {
 		return dto.GetSessionAttendeesResponse{}, err
 	}
 
-	countSessionAttendees, err := s.repo.CountAttendees(
-		ctx,
-		query.ID,
-		uuid.Nil,
-		time.Time{},
-		time.Time{},
-		false,
+	sessionAttendees, nextCursor, prevCursor := cursor.Page(
+		sessionAttendees,
+		query.Limit,
+		after,
+		query.SortBy,
+		desc,
+		func(sessionAttendee entity.SessionAttendee) (string, string) {
+			return sessionAttendeeSortValue(sessionAttendee, query.SortBy), sessionAttendee.UserID.String()
+		},
 	)
-	if err != nil {
-		return dto.GetSessionAttendeesResponse{}, err
+
+	meta := dto.PaginationResponse{
+		Limit:      query.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}
 
-	totalPage := int(countSessionAttendees) / query.Limit
-	if int(countSessionAttendees)%query.Limit != 0 {
-		totalPage++
+	if after == nil {
+		meta.Page = query.Page
 	}
 
-	meta := dto.PaginationResponse{
-		TotalData: countSessionAttendees,
-		TotalPage: totalPage,
-		Page:      query.Page,
-		Limit:     query.Limit,
+	// counting is the expensive part, so cursor clients have to ask for it
+	includeTotal := after == nil
+	if query.IncludeTotal != nil {
+		includeTotal = *query.IncludeTotal
+	}
+
+	if includeTotal {
+		countSessionAttendees, err := s.repo.CountAttendees(
+			ctx,
+			query.ID,
+			uuid.Nil,
+			time.Time{},
+			time.Time{},
+			false,
+		)
+		if err != nil {
+			return dto.GetSessionAttendeesResponse{}, err
+		}
+
+		totalPage := int(countSessionAttendees) / query.Limit
+		if int(countSessionAttendees)%query.Limit != 0 {
+			totalPage++
+		}
+
+		meta.TotalData = &countSessionAttendees
+		meta.TotalPage = &totalPage
+	}
+
+	// registration answers are personal, only the speaker and coordinators read them
+	answers := map[uuid.UUID][]dto.RegistrationAnswerResponse{}
+	canReadAnswers := query.ViewerRole == 2 || query.ViewerRole == 3
+	if !canReadAnswers {
+		session, err := s.repo.FindByID(ctx, query.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return dto.GetSessionAttendeesResponse{}, err
+		}
+
+		canReadAnswers = err == nil && session.ProposerID == query.ViewerID
+	}
+
+	if canReadAnswers {
+		_, answers, err = s.findRegistrationAnswers(ctx, query.ID)
+		if err != nil {
+			return dto.GetSessionAttendeesResponse{}, err
+		}
 	}
 
 	sessionAttendeesResponse := []dto.SessionAttendeeResponse{}
 	for _, sessionAttendee := range sessionAttendees {
 		sessionAttendeesResponse = append(sessionAttendeesResponse, dto.SessionAttendeeResponse{
-			SessionID: sessionAttendee.SessionID,
-			UserID:    sessionAttendee.UserID,
-			Reason:    sessionAttendee.Reason.String,
-			Review:    sessionAttendee.Review.String,
+			SessionID:    sessionAttendee.SessionID,
+			UserID:       sessionAttendee.UserID,
+			Reason:       sessionAttendee.Reason.String,
+			Review:       sessionAttendee.Review.String,
+			ReviewEdited: sessionAttendee.ReviewEditedAt.Valid,
 			User: dto.UserResponse{
 				ID:    sessionAttendee.User.ID,
 				Name:  sessionAttendee.User.Name,
 				Email: sessionAttendee.User.Email,
 			},
+			Answers: answers[sessionAttendee.UserID],
 		})
 	}
 
@@ -323,6 +638,16 @@ func (s *sessionService) GetSession(
 		return dto.GetSessionEventResponse{}, err
 	}
 
+	canView, err := s.canViewSession(ctx, session, query.ViewerID, query.ViewerRole, query.InviteCode)
+	if err != nil {
+		return dto.GetSessionEventResponse{}, err
+	}
+
+	// invite-only sessions stay hidden rather than admitting they exist
+	if !canView {
+		return dto.GetSessionEventResponse{}, domain.ErrSessionNotFound
+	}
+
 	countSessionAttendees, err := s.repo.CountAttendees(
 		ctx,
 		query.ID,
@@ -335,27 +660,60 @@ func (s *sessionService) GetSession(
 		return dto.GetSessionEventResponse{}, err
 	}
 
+	viewerTimeZone, err := s.viewerTimeZone(ctx, query.ViewerID)
+	if err != nil {
+		return dto.GetSessionEventResponse{}, err
+	}
+
+	timeZone := cmp.Or(viewerTimeZone, session.TimeZone)
 	sessionResponse := dto.SessionResponse{
-		ID:          session.ID,
-		Title:       session.Title,
-		Description: session.Description.String,
-		Type:        session.Type,
-		Tags:        session.TagsArray(),
-		StartAt:     session.StartAt,
-		EndAt:       session.EndAt,
-		Room:        session.Room.String,
-		MeetingURL:  session.MeetingURL.String,
-		Capacity:    session.Capacity,
-		ImageURI:    session.ImageURI.String,
-		Status:      session.Status,
+		ID:               session.ID,
+		Title:            session.Title,
+		Description:      session.Description.String,
+		Type:             session.Type,
+		Tags:             session.TagsArray(),
+		StartAt:          session.StartAt.UTC(),
+		EndAt:            session.EndAt.UTC(),
+		TimeZone:         timeZone,
+		StartAtLocal:     timezone.WallTime(session.StartAt, timeZone),
+		EndAtLocal:       timezone.WallTime(session.EndAt, timeZone),
+		Room:             session.Room.String,
+		MeetingURL:       session.MeetingURL.String,
+		Capacity:         session.Capacity,
+		RequiresApproval: session.RequiresApproval,
+		ImageURI:         session.ImageURI.String,
+		Status:           session.Status,
+		Visibility:       session.Visibility,
 		Proposer: dto.UserResponse{
 			ID:    session.Proposer.ID,
 			Name:  session.Proposer.Name,
 			Email: session.Proposer.Email,
 		},
 		CountAttendees: countSessionAttendees,
+		EventID:        session.EventID,
+	}
+
+	if session.CancelledAt.Valid {
+		sessionResponse.CancelledAt = &session.CancelledAt.Time
+		sessionResponse.CancelledReason = session.CancelledReason.String
+	}
+
+	registrationQuestions, err := s.repo.FindRegistrationQuestions(ctx, session.ID)
+	if err != nil {
+		return dto.GetSessionEventResponse{}, err
+	}
+
+	if len(registrationQuestions) > 0 {
+		sessionResponse.RegistrationQuestions = toRegistrationQuestionResponses(registrationQuestions)
+	}
+
+	prerequisites, err := s.findPrerequisiteResponses(ctx, []uuid.UUID{session.ID})
+	if err != nil {
+		return dto.GetSessionEventResponse{}, err
 	}
 
+	sessionResponse.Prerequisites = prerequisites[session.ID]
+
 	res := dto.GetSessionEventResponse{
 		Session: sessionResponse,
 	}
@@ -379,6 +737,9 @@ func (s *sessionService) GetSessions(ctx context.Context, query dto.GetSessionsQ
 
 	if query.SortBy == "" {
 		query.SortBy = "start_at"
+		if query.Search != "" {
+			query.SortBy = "relevance"
+		}
 	}
 
 	if query.SortOrder == "" {
@@ -410,10 +771,36 @@ func (s *sessionService) GetSessions(ctx context.Context, query dto.GetSessionsQ
 		return dto.GetSessionsResponse{}, err
 	}
 
+	desc := strings.EqualFold(query.SortOrder, "desc")
+
+	var after *cursor.Cursor
+	if query.Cursor != "" {
+		// ranks are recomputed per query, there is no stable key to resume from
+		if query.SortBy == "relevance" {
+			return dto.GetSessionsResponse{}, domain.ErrCursorSortNotSupported
+		}
+
+		after, err = cursor.Decode(query.Cursor, query.SortBy, desc)
+		if err != nil {
+			return dto.GetSessionsResponse{}, domain.ErrInvalidCursor
+		}
+	}
+
+	offset := query.Limit * (query.Page - 1)
+	if after != nil {
+		offset = 0
+	}
+
+	// event coordinators and admins see every session, users only the visible ones
+	viewerID := query.ViewerID
+	if query.ViewerRole == 2 || query.ViewerRole == 3 {
+		viewerID = uuid.Nil
+	}
+
 	sessions, err := s.repo.FindAll(
 		ctx,
-		query.Limit,
-		query.Limit*(query.Page-1),
+		query.Limit+1, // the extra row tells whether there is a next page
+		offset,
 		query.SortBy,
 		query.SortOrder,
 		query.Search,
@@ -424,82 +811,243 @@ func (s *sessionService) GetSessions(ctx context.Context, query dto.GetSessionsQ
 		query.ProposerID,
 		query.Status,
 		query.UserID,
+		viewerID,
+		after,
 	)
 	if err != nil {
 		return dto.GetSessionsResponse{}, err
 	}
 
-	totalData, err := s.repo.Count(
-		ctx,
-		query.Search,
-		query.Type,
-		int16(tagsNumber&0xFFFF), // 0xFFFF is used to get the last 16 bits
-		query.BeforeAt,
-		query.AfterAt,
-		query.ProposerID,
-		query.Status,
-	)
-	if err != nil {
-		return dto.GetSessionsResponse{}, err
+	var nextCursor, prevCursor string
+	if query.SortBy == "relevance" {
+		sessions = sessions[:min(len(sessions), query.Limit)]
+	} else {
+		sessions, nextCursor, prevCursor = cursor.Page(
+			sessions,
+			query.Limit,
+			after,
+			query.SortBy,
+			desc,
+			func(session entity.Session) (string, string) {
+				return sessionSortValue(session, query.SortBy), session.ID.String()
+			},
+		)
 	}
 
-	totalPage := int(totalData) / query.Limit
-	if int(totalData)%query.Limit != 0 {
-		totalPage++
+	meta := dto.PaginationResponse{
+		Limit:      query.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
 	}
 
-	meta := dto.PaginationResponse{
-		TotalData: totalData,
-		TotalPage: totalPage,
-		Page:      query.Page,
-		Limit:     query.Limit,
+	if after == nil {
+		meta.Page = query.Page
 	}
 
-	sessionsResponse := []dto.SessionResponse{}
-	for _, session := range sessions {
-		countSessionAttendees, err := s.repo.CountAttendees(
+	// counting is the expensive part, so cursor clients have to ask for it
+	includeTotal := after == nil
+	if query.IncludeTotal != nil {
+		includeTotal = *query.IncludeTotal
+	}
+
+	if includeTotal {
+		totalData, err := s.repo.Count(
 			ctx,
-			session.ID,
-			uuid.Nil,
-			time.Time{},
-			time.Time{},
-			false,
+			query.Search,
+			query.Type,
+			int16(tagsNumber&0xFFFF), // 0xFFFF is used to get the last 16 bits
+			query.BeforeAt,
+			query.AfterAt,
+			query.ProposerID,
+			query.Status,
+			query.UserID,
+			viewerID,
 		)
-
 		if err != nil {
 			return dto.GetSessionsResponse{}, err
 		}
 
-		sessionsResponse = append(sessionsResponse, dto.SessionResponse{
-			ID:          session.ID,
-			Title:       session.Title,
-			Description: session.Description.String,
-			Type:        session.Type,
-			Tags:        session.TagsArray(),
-			StartAt:     session.StartAt,
-			EndAt:       session.EndAt,
-			Room:        session.Room.String,
-			MeetingURL:  session.MeetingURL.String,
-			Capacity:    session.Capacity,
-			ImageURI:    session.ImageURI.String,
-			Status:      session.Status,
+		totalPage := int(totalData) / query.Limit
+		if int(totalData)%query.Limit != 0 {
+			totalPage++
+		}
+
+		meta.TotalData = &totalData
+		meta.TotalPage = &totalPage
+	}
+
+	var facets *dto.SessionFacetsResponse
+	if query.Facets {
+		facetCounts, err := s.repo.CountFacets(
+			ctx,
+			query.Search,
+			query.Type,
+			int16(tagsNumber&0xFFFF), // 0xFFFF is used to get the last 16 bits
+			query.BeforeAt,
+			query.AfterAt,
+			query.ProposerID,
+			query.Status,
+			query.UserID,
+			viewerID,
+		)
+		if err != nil {
+			return dto.GetSessionsResponse{}, err
+		}
+
+		facets = newSessionFacetsResponse(facetCounts)
+	}
+
+	viewerTimeZone, err := s.viewerTimeZone(ctx, query.ViewerID)
+	if err != nil {
+		return dto.GetSessionsResponse{}, err
+	}
+
+	sessionIDs := make([]uuid.UUID, 0, len(sessions))
+	for _, session := range sessions {
+		sessionIDs = append(sessionIDs, session.ID)
+	}
+
+	prerequisites, err := s.findPrerequisiteResponses(ctx, sessionIDs)
+	if err != nil {
+		return dto.GetSessionsResponse{}, err
+	}
+
+	sessionsResponse := []dto.SessionResponse{}
+	for _, session := range sessions {
+		countSessionAttendees, err := s.repo.CountAttendees(
+			ctx,
+			session.ID,
+			uuid.Nil,
+			time.Time{},
+			time.Time{},
+			false,
+		)
+
+		if err != nil {
+			return dto.GetSessionsResponse{}, err
+		}
+
+		timeZone := cmp.Or(viewerTimeZone, session.TimeZone)
+		sessionResponse := dto.SessionResponse{
+			ID:               session.ID,
+			Title:            session.Title,
+			Description:      session.Description.String,
+			Type:             session.Type,
+			Tags:             session.TagsArray(),
+			StartAt:          session.StartAt.UTC(),
+			EndAt:            session.EndAt.UTC(),
+			TimeZone:         timeZone,
+			StartAtLocal:     timezone.WallTime(session.StartAt, timeZone),
+			EndAtLocal:       timezone.WallTime(session.EndAt, timeZone),
+			Room:             session.Room.String,
+			MeetingURL:       session.MeetingURL.String,
+			Capacity:         session.Capacity,
+			RequiresApproval: session.RequiresApproval,
+			ImageURI:         session.ImageURI.String,
+			Status:           session.Status,
+			Visibility:       session.Visibility,
 			Proposer: dto.UserResponse{
 				ID:    session.Proposer.ID,
 				Name:  session.Proposer.Name,
 				Email: session.Proposer.Email,
 			},
 			CountAttendees: countSessionAttendees,
-		})
+			EventID:        session.EventID,
+			Highlight:      session.Highlight.String,
+		}
+
+		if session.CancelledAt.Valid {
+			sessionResponse.CancelledAt = &session.CancelledAt.Time
+			sessionResponse.CancelledReason = session.CancelledReason.String
+		}
+
+		sessionResponse.Prerequisites = prerequisites[session.ID]
+
+		sessionsResponse = append(sessionsResponse, sessionResponse)
 	}
 
 	res := dto.GetSessionsResponse{
 		Sessions: sessionsResponse,
 		Meta:     meta,
+		Facets:   facets,
 	}
 
 	return res, nil
 }
 
+// Sort value of an attendee as a cursor stores it.
+func sessionAttendeeSortValue(sessionAttendee entity.SessionAttendee, sortBy string) string {
+	switch sortBy {
+	case "id":
+		return sessionAttendee.SessionID.String()
+	case "title":
+		return sessionAttendee.Session.Title
+	case "start_at":
+		return sessionAttendee.Session.StartAt.Format(time.RFC3339Nano)
+	case "end_at":
+		return sessionAttendee.Session.EndAt.Format(time.RFC3339Nano)
+	case "capacity":
+		return strconv.Itoa(sessionAttendee.Session.Capacity)
+	}
+
+	return sessionAttendee.UserID.String()
+}
+
+// Sort value of a session as a cursor stores it.
+func sessionSortValue(session entity.Session, sortBy string) string {
+	switch sortBy {
+	case "title":
+		return session.Title
+	case "start_at":
+		return session.StartAt.Format(time.RFC3339Nano)
+	case "end_at":
+		return session.EndAt.Format(time.RFC3339Nano)
+	case "room":
+		return session.Room.String
+	case "capacity":
+		return strconv.Itoa(session.Capacity)
+	}
+
+	return session.ID.String()
+}
+
+func newSessionFacetsResponse(facetCounts []entity.SessionFacetCount) *dto.SessionFacetsResponse {
+	facets := &dto.SessionFacetsResponse{
+		Tags:      []dto.SessionFacetCountResponse{},
+		Types:     []dto.SessionFacetCountResponse{},
+		Statuses:  []dto.SessionFacetCountResponse{},
+		Days:      []dto.SessionFacetCountResponse{},
+		Locations: []dto.SessionFacetCountResponse{},
+	}
+
+	for _, facetCount := range facetCounts {
+		res := dto.SessionFacetCountResponse{
+			Value: facetCount.Value,
+			Count: facetCount.Count,
+		}
+
+		switch facetCount.Facet {
+		case "tag":
+			res.Label = sessionTagLabel(facetCount.Value)
+			facets.Tags = append(facets.Tags, res)
+		case "type":
+			sessionType, _ := strconv.ParseInt(facetCount.Value, 10, 16)
+			res.Label = enums.SessionType[int16(sessionType)]
+			facets.Types = append(facets.Types, res)
+		case "status":
+			status, _ := strconv.ParseInt(facetCount.Value, 10, 16)
+			res.Label = enums.SessionStatus[int16(status)]
+			facets.Statuses = append(facets.Statuses, res)
+		case "day":
+			facets.Days = append(facets.Days, res)
+		case "location":
+			facets.Locations = append(facets.Locations, res)
+		}
+	}
+
+	return facets
+}
+
 func (s *sessionService) RejectSession(
 	ctx context.Context,
 	query dto.RejectSessionQuery,
@@ -519,10 +1067,14 @@ func (s *sessionService) RejectSession(
 		return err
 	}
 
+	return s.rejectSession(ctx, session, req.Reason)
+}
+
+func (s *sessionService) rejectSession(ctx context.Context, session *entity.Session, reason string) error {
 	session.Status = 3 // Rejected
-	session.DeletedReason = sql.NullString{String: req.Reason, Valid: true}
+	session.DeletedReason = sql.NullString{String: reason, Valid: true}
 
-	err = s.repo.Update(ctx, session)
+	err := s.repo.Update(ctx, session)
 	if err != nil {
 		return err
 	}
@@ -554,6 +1106,8 @@ func (s *sessionService) UpdateSession(ctx context.Context, req dto.UpdateSessio
 		return domain.ErrSessionAlreadyStarted
 	}
 
+	startAt := session.StartAt
+
 	if session.EndAt.Before(now) {
 		return domain.ErrSessionAlreadyEnded
 	}
@@ -617,7 +1171,58 @@ func (s *sessionService) UpdateSession(ctx context.Context, req dto.UpdateSessio
 		session.Capacity = req.Capacity
 	}
 
-	err = s.repo.Update(ctx, session)
+	if req.Visibility != 0 {
+		session.Visibility = req.Visibility
+	}
+
+	if req.RequiresApproval != nil {
+		session.RequiresApproval = *req.RequiresApproval
+	}
+
+	if req.EventID != uuid.Nil {
+		timeZone, err := s.sessionTimeZone(ctx, req.EventID, "")
+		if err != nil {
+			return err
+		}
+
+		session.EventID = uuid.NullUUID{UUID: req.EventID, Valid: true}
+		session.TimeZone = timeZone
+	} else if req.TimeZone != "" && !session.EventID.Valid {
+		session.TimeZone = req.TimeZone
+	}
+
+	// prerequisites start earlier than the sessions requiring them, so moving
+	// the session must keep it between the two
+	moved := !session.StartAt.Equal(startAt)
+	if req.PrerequisiteIDs != nil {
+		err = s.checkPrerequisites(ctx, session.ID, session.StartAt, *req.PrerequisiteIDs)
+	} else if moved {
+		err = s.checkCurrentPrerequisites(ctx, session.ID, session.StartAt)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if moved {
+		err = s.checkDependentSessions(ctx, session.ID, session.StartAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.Update(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		if req.PrerequisiteIDs == nil {
+			return nil
+		}
+
+		return s.repo.ReplaceSessionPrerequisites(ctx, session.ID, *req.PrerequisiteIDs)
+	})
 	if err != nil {
 		return err
 	}
@@ -629,90 +1234,204 @@ func (s *sessionService) RegisterSession(
 	ctx context.Context,
 	query dto.RegisterSessionQuery,
 	req dto.RegisterSessionRequest,
-) error {
+) (dto.RegisterSessionResponse, error) {
 	valErr := s.validator.Validate(query)
 	if valErr != nil {
-		return valErr
+		return dto.RegisterSessionResponse{}, valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return dto.RegisterSessionResponse{}, valErr
 	}
 
 	session, err := s.repo.FindByID(ctx, query.SessionID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrSessionNotFound
+			return dto.RegisterSessionResponse{}, domain.ErrSessionNotFound
 		}
 
-		return err
+		return dto.RegisterSessionResponse{}, err
 	}
 
 	pastSessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, req.UserID)
 	if err == nil {
 		if pastSessionAttendee.Reason.Valid {
-			return domain.ErrSessionCancelled
+			return dto.RegisterSessionResponse{}, domain.ErrSessionCancelled
 		}
 
-		return domain.ErrSessionAlreadyRegistered
+		return dto.RegisterSessionResponse{}, domain.ErrSessionAlreadyRegistered
 	}
 
 	if !errors.Is(err, sql.ErrNoRows) {
-		return err
+		return dto.RegisterSessionResponse{}, err
+	}
+
+	if session.RequiresApproval {
+		request, err := s.repo.FindRegistrationRequest(ctx, query.SessionID, req.UserID)
+		if err == nil {
+			if request.Status == 3 { // declined
+				return dto.RegisterSessionResponse{}, domain.ErrRegistrationDeclined
+			}
+
+			return dto.RegisterSessionResponse{}, domain.ErrRegistrationPending
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return dto.RegisterSessionResponse{}, err
+		}
+	}
+
+	if session.Status == 4 {
+		return dto.RegisterSessionResponse{}, domain.ErrSessionIsCancelled
 	}
 
 	if session.Status != 2 {
-		return domain.ErrSessionNotAccepted
+		return dto.RegisterSessionResponse{}, domain.ErrSessionNotAccepted
 	}
 
 	now := time.Now()
 	if session.StartAt.Before(now) {
-		return domain.ErrSessionAlreadyStarted
+		return dto.RegisterSessionResponse{}, domain.ErrSessionAlreadyStarted
 	}
 
 	if session.EndAt.Before(now) {
-		return domain.ErrSessionAlreadyEnded
+		return dto.RegisterSessionResponse{}, domain.ErrSessionAlreadyEnded
 	}
 
-	countSessionAttendees, err := s.repo.CountAttendees(
+	countUserSessionTimeConflict, err := s.repo.CountAttendees(
 		ctx,
-		query.SessionID,
 		uuid.Nil,
+		req.UserID,
 		session.EndAt,
 		session.StartAt,
 		false,
 	)
 	if err != nil {
-		return err
+		return dto.RegisterSessionResponse{}, err
 	}
 
-	if int(countSessionAttendees) >= session.Capacity {
-		return domain.ErrSessionFull
+	if countUserSessionTimeConflict > 0 {
+		return dto.RegisterSessionResponse{}, domain.ErrSessionTimeConflict
 	}
 
-	countUserSessionTimeConflict, err := s.repo.CountAttendees(
-		ctx,
-		uuid.Nil,
-		req.UserID,
-		session.EndAt,
-		session.StartAt,
-		false,
-	)
+	err = s.checkPrerequisitesMet(ctx, session.ID, req.UserID)
 	if err != nil {
-		return err
+		return dto.RegisterSessionResponse{}, err
 	}
 
-	if countUserSessionTimeConflict > 0 {
-		return domain.ErrSessionTimeConflict
+	// allowlisted users register directly, everyone else spends a use of a code
+	useInviteCode := false
+	if session.Visibility == 3 { // invite only
+		invited, err := s.repo.IsSessionInvitee(ctx, session.ID, req.UserID)
+		if err != nil {
+			return dto.RegisterSessionResponse{}, err
+		}
+
+		if !invited {
+			if req.InviteCode == "" {
+				return dto.RegisterSessionResponse{}, domain.ErrSessionInviteRequired
+			}
+
+			useInviteCode = true
+		}
 	}
 
-	sessionAttendee := entity.SessionAttendee{
-		SessionID: query.SessionID,
-		UserID:    req.UserID,
+	registrationQuestions, err := s.repo.FindRegistrationQuestions(ctx, session.ID)
+	if err != nil {
+		return dto.RegisterSessionResponse{}, err
 	}
 
-	err = s.repo.CreateSessionAttendee(ctx, &sessionAttendee)
+	answers, err := s.newRegistrationAnswers(session.ID, req.UserID, registrationQuestions, req.Answers)
 	if err != nil {
-		return err
+		return dto.RegisterSessionResponse{}, err
 	}
 
-	return nil
+	var event *entity.Event
+	if session.EventID.Valid {
+		event, err = s.eventRepo.FindByID(ctx, session.EventID.UUID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return dto.RegisterSessionResponse{}, domain.ErrEventNotFound
+			}
+
+			return dto.RegisterSessionResponse{}, err
+		}
+	}
+
+	// capacity and quotas are checked with the session and the user's
+	// registrations locked, so concurrent registrations can't overbook either
+	err = s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.LockSession(ctx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		countSessionAttendees, err := s.repo.CountAttendees(
+			ctx,
+			session.ID,
+			uuid.Nil,
+			session.EndAt,
+			session.StartAt,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+
+		// a pending request holds no seat, so a full session can still queue applicants
+		if int(countSessionAttendees) >= session.Capacity && !session.RequiresApproval {
+			return domain.ErrSessionFull
+		}
+
+		if event != nil {
+			err = s.checkRegistrationQuotas(ctx, event, session, req.UserID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if useInviteCode {
+			err = s.repo.UseSessionInvite(ctx, session.ID, req.InviteCode)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return domain.ErrInvalidInviteCode
+				}
+
+				return err
+			}
+		}
+
+		// answers are kept apart from the registration, so an approval needs no copy
+		err = s.repo.SaveRegistrationAnswers(ctx, session.ID, req.UserID, answers)
+		if err != nil {
+			return err
+		}
+
+		if session.RequiresApproval {
+			return s.repo.CreateRegistrationRequest(ctx, &entity.RegistrationRequest{
+				SessionID: query.SessionID,
+				UserID:    req.UserID,
+				Status:    1, // pending
+			})
+		}
+
+		return s.repo.CreateSessionAttendee(ctx, &entity.SessionAttendee{
+			SessionID: query.SessionID,
+			UserID:    req.UserID,
+		})
+	})
+	if err != nil {
+		return dto.RegisterSessionResponse{}, err
+	}
+
+	if session.RequiresApproval {
+		return dto.RegisterSessionResponse{Status: "pending"}, nil
+	}
+
+	s.publishSessionSeats(ctx, session)
+
+	return dto.RegisterSessionResponse{Status: "registered"}, nil
 }
 
 func (s *sessionService) UnregisterSession(
@@ -734,6 +1453,10 @@ func (s *sessionService) UnregisterSession(
 		return err
 	}
 
+	if session.Status == 4 {
+		return domain.ErrSessionIsCancelled
+	}
+
 	if session.Status != 2 {
 		return domain.ErrSessionNotAccepted
 	}
@@ -750,7 +1473,7 @@ func (s *sessionService) UnregisterSession(
 	sessionAttendee, err := s.repo.FindSessionAttendee(ctx, query.SessionID, req.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrSessionNotRegistered
+			return s.withdrawRegistrationRequest(ctx, query.SessionID, req.UserID)
 		}
 
 		return err
@@ -767,6 +1490,8 @@ func (s *sessionService) UnregisterSession(
 		return err
 	}
 
+	s.publishSessionSeats(ctx, session)
+
 	return nil
 }
 
@@ -875,32 +1600,59 @@ func (s *sessionService) DeleteReviewSession(
 
 	sessionAttendee.DeletedReason = sql.NullString{String: req.Reason, Valid: true}
 
-	err = s.repo.UpdateSessionAttendee(ctx, sessionAttendee)
-	if err != nil {
-		return err
-	}
+	return s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.repo.UpdateSessionAttendee(ctx, sessionAttendee)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		return s.recordReviewModeration(ctx, sessionAttendee, req.ModeratorID, 1, req.Reason) // hide
+	})
 }
 
 func (s *sessionService) CancelSession(
 	ctx context.Context,
 	query dto.CancelSessionQuery,
+	req dto.CancelSessionRequest,
 ) error {
 	valErr := s.validator.Validate(query)
 	if valErr != nil {
 		return valErr
 	}
 
-	session, err := s.repo.FindByID(ctx, query.ID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return domain.ErrSessionNotFound
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	var session *entity.Session
+	err := s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		session, err = s.findLockedSession(ctx, query.ID)
+		if err != nil {
+			return err
 		}
 
+		return s.cancelSession(ctx, session, req.Reason)
+	})
+	if err != nil {
 		return err
 	}
 
+	s.publishSessionSeats(ctx, session)
+
+	return nil
+}
+
+// Marks the session as cancelled, releases its registrations and notifies the
+// released attendees. Callers hold the session lock, so no registration can
+// take a seat after the status check. Seat updates are left to the caller so
+// they can be published once the changes are committed.
+func (s *sessionService) cancelSession(ctx context.Context, session *entity.Session, reason string) error {
+	if session.Status == 4 {
+		return domain.ErrSessionIsCancelled
+	}
+
 	if session.Status != 2 {
 		return domain.ErrSessionNotAccepted
 	}
@@ -914,9 +1666,208 @@ func (s *sessionService) CancelSession(
 		return domain.ErrSessionAlreadyEnded
 	}
 
-	session.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	session.Status = 4 // Cancelled
+	session.CancelledAt = sql.NullTime{Time: now, Valid: true}
+	session.CancelledReason = sql.NullString{String: reason, Valid: true}
 
-	err = s.repo.Update(ctx, session)
+	err := s.repo.Update(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	userIDs, err := s.repo.ReleaseSessionAttendees(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.notifyUsers(
+		ctx,
+		session,
+		userIDs,
+		1, // session cancelled
+		"Session cancelled",
+		fmt.Sprintf("%s has been cancelled: %s. Your registration has been released.", session.Title, reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Locks the session before reading it, so a concurrent cancel, revert or
+// registration waits until the caller's transaction ends and the status it
+// checks stays current. Must run inside a transaction.
+func (s *sessionService) findLockedSession(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	err := s.repo.LockSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	session, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *sessionService) RevertCancelSession(ctx context.Context, query dto.RevertCancelSessionQuery) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	var session *entity.Session
+	err := s.repo.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		session, err = s.findLockedSession(ctx, query.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.revertCancelSession(ctx, session)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishSessionSeats(ctx, session)
+
+	return nil
+}
+
+// Puts the session back on schedule and restores the registrations released
+// by the cancellation as far as seats and the attendees' schedules allow.
+// Callers hold the session lock.
+func (s *sessionService) revertCancelSession(ctx context.Context, session *entity.Session) error {
+	if session.Status != 4 {
+		return domain.ErrSessionNotCancelled
+	}
+
+	if session.StartAt.Before(time.Now()) {
+		return domain.ErrSessionAlreadyStarted
+	}
+
+	session.Status = 2 // Accepted
+	session.CancelledAt = sql.NullTime{}
+	session.CancelledReason = sql.NullString{}
+
+	err := s.repo.Update(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	sessionAttendees, err := s.repo.FindReleasedSessionAttendees(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+
+	countSessionAttendees, err := s.repo.CountAttendees(
+		ctx,
+		session.ID,
+		uuid.Nil,
+		time.Time{},
+		time.Time{},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+
+	// a released seat can't come back if its owner has since taken a session in
+	// the same slot, or if the session no longer has room for it
+	restoredUserIDs := []uuid.UUID{}
+	conflictedUserIDs := []uuid.UUID{}
+	fullUserIDs := []uuid.UUID{}
+	for _, sessionAttendee := range sessionAttendees {
+		countUserSessionTimeConflict, err := s.repo.CountAttendees(
+			ctx,
+			uuid.Nil,
+			sessionAttendee.UserID,
+			session.EndAt,
+			session.StartAt,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+
+		isFull := int(countSessionAttendees) >= session.Capacity
+		if countUserSessionTimeConflict > 0 || isFull {
+			err = s.repo.DeleteSessionAttendee(ctx, session.ID, sessionAttendee.UserID)
+			if err != nil {
+				return err
+			}
+
+			if countUserSessionTimeConflict > 0 {
+				conflictedUserIDs = append(conflictedUserIDs, sessionAttendee.UserID)
+			} else {
+				fullUserIDs = append(fullUserIDs, sessionAttendee.UserID)
+			}
+
+			continue
+		}
+
+		sessionAttendee.ReleasedAt = sql.NullTime{}
+
+		err = s.repo.UpdateSessionAttendee(ctx, &sessionAttendee)
+		if err != nil {
+			return err
+		}
+
+		restoredUserIDs = append(restoredUserIDs, sessionAttendee.UserID)
+		countSessionAttendees++
+	}
+
+	err = s.notifyUsers(
+		ctx,
+		session,
+		restoredUserIDs,
+		2, // session restored
+		"Session restored",
+		fmt.Sprintf("%s is back on schedule and your registration has been restored.", session.Title),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = s.notifyUsers(
+		ctx,
+		session,
+		conflictedUserIDs,
+		3, // registration released
+		"Session restored",
+		fmt.Sprintf(
+			"%s is back on schedule, but your registration could not be restored "+
+				"because you joined another session at the same time.",
+			session.Title,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = s.notifyUsers(
+		ctx,
+		session,
+		fullUserIDs,
+		3, // registration released
+		"Session restored",
+		fmt.Sprintf(
+			"%s is back on schedule, but your registration could not be restored "+
+				"because the session has no seats left.",
+			session.Title,
+		),
+	)
 	if err != nil {
 		return err
 	}
@@ -924,14 +1875,102 @@ func (s *sessionService) CancelSession(
 	return nil
 }
 
+func (s *sessionService) notifyUsers(
+	ctx context.Context,
+	session *entity.Session,
+	userIDs []uuid.UUID,
+	notificationType int16,
+	title string,
+	body string,
+) error {
+	notifications := []entity.Notification{}
+	for _, userID := range userIDs {
+		id, err := s.uuidPkg.NewV7()
+		if err != nil {
+			return err
+		}
+
+		notifications = append(notifications, entity.Notification{
+			ID:        id,
+			UserID:    userID,
+			Type:      notificationType,
+			Title:     title,
+			Body:      body,
+			SessionID: uuid.NullUUID{UUID: session.ID, Valid: true},
+		})
+	}
+
+	return s.notificationRepo.CreateMany(ctx, notifications)
+}
+
+func (s *sessionService) checkEventExists(ctx context.Context, eventID uuid.UUID) error {
+	_, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Sessions of an event follow the event's time zone, others use the requested
+// one or the default.
+func (s *sessionService) sessionTimeZone(ctx context.Context, eventID uuid.UUID, requested string) (string, error) {
+	if eventID == uuid.Nil {
+		return cmp.Or(requested, timezone.Default.String()), nil
+	}
+
+	event, err := s.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrEventNotFound
+		}
+
+		return "", err
+	}
+
+	return event.TimeZone, nil
+}
+
+// The viewer's preferred time zone, empty when they have not set one.
+func (s *sessionService) viewerTimeZone(ctx context.Context, viewerID uuid.UUID) (string, error) {
+	if viewerID == uuid.Nil {
+		return "", nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, viewerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return user.TimeZone.String, nil
+}
+
 func NewSessionService(
 	repo contracts.SessionRepository,
+	eventRepo contracts.EventRepository,
+	userRepo contracts.UserRepository,
+	notificationRepo contracts.NotificationRepository,
+	pubsub pubsub.CustomPubSubInterface,
+	spreadsheet spreadsheet.CustomSpreadsheetInterface,
 	validator validator.ValidatorInterface,
 	uuidPkg uuidPkg.CustomUUIDInterface,
 ) contracts.SessionService {
 	return &sessionService{
-		repo:      repo,
-		validator: validator,
-		uuidPkg:   uuidPkg,
+		repo:             repo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		pubsub:           pubsub,
+		spreadsheet:      spreadsheet,
+		validator:        validator,
+		uuidPkg:          uuidPkg,
 	}
 }
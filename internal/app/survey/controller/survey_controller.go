@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+)
+
+type surveyController struct {
+	service contracts.SurveyService
+}
+
+func InitSurveyController(router fiber.Router, service contracts.SurveyService, middleware *middlewares.Middleware) {
+	controller := surveyController{
+		service: service,
+	}
+
+	surveyRouter := router.Group("/surveys")
+
+	surveyRouter.Get(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.GetSurveys,
+	)
+	surveyRouter.Get("/:id", middleware.RequireAuth(), controller.GetSurvey)
+	surveyRouter.Post(
+		"/",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.CreateSurvey,
+	)
+	surveyRouter.Delete(
+		"/:id",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.DeleteSurvey,
+	)
+	surveyRouter.Get(
+		"/:id/results",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.GetSurveyResults,
+	)
+	surveyRouter.Get(
+		"/:id/results/export",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{2}), // event coordinator
+		controller.ExportSurveyResults,
+	)
+
+	sessionRouter := router.Group("/sessions")
+
+	sessionRouter.Get(
+		"/:sessionID/surveys",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.GetSessionSurveys,
+	)
+	sessionRouter.Post(
+		"/:sessionID/surveys/:surveyID/responses",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{1}), // user
+		controller.SubmitSurveyResponse,
+	)
+}
+
+func (s *surveyController) GetSurveys(ctx *fiber.Ctx) error {
+	var query dto.GetSurveysQuery
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := s.service.GetSurveys(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *surveyController) GetSurvey(ctx *fiber.Ctx) error {
+	var query dto.GetSurveyQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := s.service.GetSurvey(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *surveyController) CreateSurvey(ctx *fiber.Ctx) error {
+	var req dto.CreateSurveyRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.CreatedBy = claims.UserID
+
+	err := s.service.CreateSurvey(ctx.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
+
+func (s *surveyController) DeleteSurvey(ctx *fiber.Ctx) error {
+	var query dto.DeleteSurveyQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := s.service.DeleteSurvey(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, nil)
+}
+
+func (s *surveyController) GetSurveyResults(ctx *fiber.Ctx) error {
+	var query dto.GetSurveyResultsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := s.service.GetSurveyResults(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *surveyController) ExportSurveyResults(ctx *fiber.Ctx) error {
+	var query dto.GetSurveyResultsQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	if err := ctx.QueryParser(&query); err != nil {
+		return err
+	}
+
+	res, err := s.service.ExportSurveyResults(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/csv")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"survey-%s.csv\"", query.ID))
+
+	return ctx.Status(fiber.StatusOK).Send(res)
+}
+
+func (s *surveyController) GetSessionSurveys(ctx *fiber.Ctx) error {
+	var query dto.GetSessionSurveysQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	res, err := s.service.GetSessionSurveys(ctx.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusOK, res)
+}
+
+func (s *surveyController) SubmitSurveyResponse(ctx *fiber.Ctx) error {
+	var query dto.SubmitSurveyResponseQuery
+	if err := ctx.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	var req dto.SubmitSurveyResponseRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return err
+	}
+
+	claims, ok := ctx.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	req.UserID = claims.UserID
+
+	err := s.service.SubmitSurveyResponse(ctx.Context(), query, req)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(ctx, fiber.StatusCreated, nil)
+}
@@ -0,0 +1,491 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type surveyRepository struct {
+	db *sqlx.DB
+}
+
+func (s *surveyRepository) FindAll(
+	ctx context.Context,
+	limit int,
+	offset int,
+	eventID uuid.UUID,
+	sessionType int16,
+) ([]entity.SurveyTemplate, error) {
+	templates := []entity.SurveyTemplate{}
+	query := "SELECT * FROM survey_templates WHERE 1=1"
+	args := []interface{}{}
+
+	if eventID != uuid.Nil {
+		query += fmt.Sprintf(" AND event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	if sessionType != 0 {
+		query += fmt.Sprintf(" AND session_type = $%d", len(args)+1)
+		args = append(args, sessionType)
+	}
+
+	query += fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	err := s.db.SelectContext(ctx, &templates, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindAll]")
+
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *surveyRepository) Count(ctx context.Context, eventID uuid.UUID, sessionType int16) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM survey_templates WHERE 1=1"
+	args := []interface{}{}
+
+	if eventID != uuid.Nil {
+		query += fmt.Sprintf(" AND event_id = $%d", len(args)+1)
+		args = append(args, eventID)
+	}
+
+	if sessionType != 0 {
+		query += fmt.Sprintf(" AND session_type = $%d", len(args)+1)
+		args = append(args, sessionType)
+	}
+
+	err := s.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][Count]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *surveyRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.SurveyTemplate, error) {
+	var template entity.SurveyTemplate
+	err := s.db.GetContext(ctx, &template, "SELECT * FROM survey_templates WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindByID]")
+
+		return nil, err
+	}
+
+	questions := []entity.SurveyQuestion{}
+	err = s.db.SelectContext(
+		ctx,
+		&questions,
+		"SELECT * FROM survey_questions WHERE template_id = $1 ORDER BY position ASC",
+		id,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindByID]")
+
+		return nil, err
+	}
+
+	options := []entity.SurveyQuestionOption{}
+	err = s.db.SelectContext(
+		ctx,
+		&options,
+		`
+		SELECT survey_question_options.* FROM survey_question_options
+		JOIN survey_questions ON survey_questions.id=survey_question_options.question_id
+		WHERE survey_questions.template_id = $1
+		ORDER BY survey_question_options.position ASC
+		`,
+		id,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindByID]")
+
+		return nil, err
+	}
+
+	for i := range questions {
+		for _, option := range options {
+			if option.QuestionID == questions[i].ID {
+				questions[i].Options = append(questions[i].Options, option)
+			}
+		}
+	}
+
+	template.Questions = questions
+
+	return &template, nil
+}
+
+func (s *surveyRepository) FindApplicable(
+	ctx context.Context,
+	eventID uuid.NullUUID,
+	sessionType int16,
+) ([]entity.SurveyTemplate, error) {
+	templates := []entity.SurveyTemplate{}
+	err := s.db.SelectContext(
+		ctx,
+		&templates,
+		`
+		SELECT * FROM survey_templates
+		WHERE (event_id IS NOT NULL AND event_id = $1) OR session_type = $2
+		ORDER BY created_at ASC
+		`,
+		eventID,
+		sessionType,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindApplicable]")
+
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *surveyRepository) Create(ctx context.Context, template *entity.SurveyTemplate) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][Create]")
+
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO survey_templates
+		(id, title, description, event_id, session_type, created_by)
+		VALUES (:id, :title, :description, :event_id, :session_type, :created_by)
+		`,
+		template,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][Create]")
+
+		return err
+	}
+
+	for _, question := range template.Questions {
+		_, err = tx.NamedExecContext(
+			ctx,
+			`
+			INSERT INTO survey_questions
+			(id, template_id, position, type, prompt, required, scale_min, scale_max)
+			VALUES (:id, :template_id, :position, :type, :prompt, :required, :scale_min, :scale_max)
+			`,
+			question,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SurveyRepository][Create]")
+
+			return err
+		}
+
+		for _, option := range question.Options {
+			_, err = tx.NamedExecContext(
+				ctx,
+				`
+				INSERT INTO survey_question_options
+				(id, question_id, position, label)
+				VALUES (:id, :question_id, :position, :label)
+				`,
+				option,
+			)
+			if err != nil {
+				log.Error(log.LogInfo{
+					"error": err,
+				}, "[SurveyRepository][Create]")
+
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *surveyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM survey_templates WHERE id = $1", id)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][Delete]")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyRepository) CountResponses(
+	ctx context.Context,
+	templateID uuid.UUID,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+) (int64, error) {
+	var count int64
+	query := "SELECT COUNT(*) FROM survey_responses WHERE template_id = $1"
+	args := []interface{}{templateID}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	if userID != uuid.Nil {
+		query += fmt.Sprintf(" AND user_id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	err := s.db.GetContext(ctx, &count, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][CountResponses]")
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *surveyRepository) CreateResponse(ctx context.Context, response *entity.SurveyResponse) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][CreateResponse]")
+
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.NamedExecContext(
+		ctx,
+		`
+		INSERT INTO survey_responses
+		(id, template_id, session_id, user_id)
+		VALUES (:id, :template_id, :session_id, :user_id)
+		`,
+		response,
+	)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][CreateResponse]")
+
+		return err
+	}
+
+	for _, answer := range response.Answers {
+		_, err = tx.NamedExecContext(
+			ctx,
+			`
+			INSERT INTO survey_answers
+			(id, response_id, question_id, option_id, scale_value, text_value)
+			VALUES (:id, :response_id, :question_id, :option_id, :scale_value, :text_value)
+			`,
+			answer,
+		)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err,
+			}, "[SurveyRepository][CreateResponse]")
+
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *surveyRepository) FindResponses(
+	ctx context.Context,
+	templateID uuid.UUID,
+	sessionID uuid.UUID,
+) ([]entity.SurveyResponse, error) {
+	query := `SELECT survey_responses.*, users.id as "user.id", users.name as "user.name",
+		users.email as "user.email", users.role as "user.role"
+		FROM survey_responses
+		JOIN users ON users.id=survey_responses.user_id
+		WHERE survey_responses.template_id = $1`
+	args := []interface{}{templateID}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND survey_responses.session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	query += " ORDER BY survey_responses.created_at ASC"
+
+	responses := []entity.SurveyResponse{}
+	err := s.db.SelectContext(ctx, &responses, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindResponses]")
+
+		return nil, err
+	}
+
+	answersQuery := `SELECT survey_answers.* FROM survey_answers
+		JOIN survey_responses ON survey_responses.id=survey_answers.response_id
+		WHERE survey_responses.template_id = $1`
+	if sessionID != uuid.Nil {
+		answersQuery += " AND survey_responses.session_id = $2"
+	}
+
+	answers := []entity.SurveyAnswer{}
+	err = s.db.SelectContext(ctx, &answers, answersQuery, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindResponses]")
+
+		return nil, err
+	}
+
+	answersByResponse := map[uuid.UUID][]entity.SurveyAnswer{}
+	for _, answer := range answers {
+		answersByResponse[answer.ResponseID] = append(answersByResponse[answer.ResponseID], answer)
+	}
+
+	for i := range responses {
+		responses[i].Answers = answersByResponse[responses[i].ID]
+	}
+
+	return responses, nil
+}
+
+func (s *surveyRepository) CountOptionAnswers(
+	ctx context.Context,
+	templateID uuid.UUID,
+	sessionID uuid.UUID,
+) ([]entity.SurveyOptionCount, error) {
+	query := `SELECT survey_answers.question_id, survey_answers.option_id, COUNT(*) as count
+		FROM survey_answers
+		JOIN survey_responses ON survey_responses.id=survey_answers.response_id
+		WHERE survey_responses.template_id = $1 AND survey_answers.option_id IS NOT NULL`
+	args := []interface{}{templateID}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND survey_responses.session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	query += " GROUP BY survey_answers.question_id, survey_answers.option_id"
+
+	counts := []entity.SurveyOptionCount{}
+	err := s.db.SelectContext(ctx, &counts, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][CountOptionAnswers]")
+
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (s *surveyRepository) SummarizeScaleAnswers(
+	ctx context.Context,
+	templateID uuid.UUID,
+	sessionID uuid.UUID,
+) ([]entity.SurveyScaleSummary, error) {
+	query := `SELECT survey_answers.question_id, COUNT(*) as count_answers,
+		AVG(survey_answers.scale_value)::float8 as average
+		FROM survey_answers
+		JOIN survey_responses ON survey_responses.id=survey_answers.response_id
+		WHERE survey_responses.template_id = $1 AND survey_answers.scale_value IS NOT NULL`
+	args := []interface{}{templateID}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND survey_responses.session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	query += " GROUP BY survey_answers.question_id"
+
+	summaries := []entity.SurveyScaleSummary{}
+	err := s.db.SelectContext(ctx, &summaries, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][SummarizeScaleAnswers]")
+
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+func (s *surveyRepository) FindTextAnswers(
+	ctx context.Context,
+	templateID uuid.UUID,
+	sessionID uuid.UUID,
+) ([]entity.SurveyAnswer, error) {
+	query := `SELECT survey_answers.* FROM survey_answers
+		JOIN survey_responses ON survey_responses.id=survey_answers.response_id
+		WHERE survey_responses.template_id = $1 AND survey_answers.text_value IS NOT NULL`
+	args := []interface{}{templateID}
+
+	if sessionID != uuid.Nil {
+		query += fmt.Sprintf(" AND survey_responses.session_id = $%d", len(args)+1)
+		args = append(args, sessionID)
+	}
+
+	query += " ORDER BY survey_responses.created_at ASC"
+
+	answers := []entity.SurveyAnswer{}
+	err := s.db.SelectContext(ctx, &answers, query, args...)
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err,
+		}, "[SurveyRepository][FindTextAnswers]")
+
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+func NewSurveyRepository(db *sqlx.DB) contracts.SurveyRepository {
+	return &surveyRepository{
+		db: db,
+	}
+}
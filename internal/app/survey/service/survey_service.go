@@ -0,0 +1,707 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	uuidPkg "github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
+	"github.com/google/uuid"
+)
+
+type surveyService struct {
+	repo        contracts.SurveyRepository
+	sessionRepo contracts.SessionRepository
+	eventRepo   contracts.EventRepository
+	validator   validator.ValidatorInterface
+	uuidPkg     uuidPkg.CustomUUIDInterface
+}
+
+func (s *surveyService) GetSurveys(ctx context.Context, query dto.GetSurveysQuery) (dto.GetSurveysResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSurveysResponse{}, valErr
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	templates, err := s.repo.FindAll(
+		ctx,
+		query.Limit,
+		query.Limit*(query.Page-1),
+		query.EventID,
+		query.SessionType,
+	)
+	if err != nil {
+		return dto.GetSurveysResponse{}, err
+	}
+
+	totalData, err := s.repo.Count(ctx, query.EventID, query.SessionType)
+	if err != nil {
+		return dto.GetSurveysResponse{}, err
+	}
+
+	totalPage := int(totalData) / query.Limit
+	if int(totalData)%query.Limit != 0 {
+		totalPage++
+	}
+
+	meta := dto.PaginationResponse{
+		TotalData: &totalData,
+		TotalPage: &totalPage,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	surveysResponse := []dto.SurveyResponse{}
+	for _, template := range templates {
+		surveysResponse = append(surveysResponse, toSurveyResponse(&template))
+	}
+
+	res := dto.GetSurveysResponse{
+		Surveys: surveysResponse,
+		Meta:    meta,
+	}
+
+	return res, nil
+}
+
+func (s *surveyService) GetSurvey(ctx context.Context, query dto.GetSurveyQuery) (dto.GetSurveyResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSurveyResponse{}, valErr
+	}
+
+	template, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetSurveyResponse{}, domain.ErrSurveyNotFound
+		}
+
+		return dto.GetSurveyResponse{}, err
+	}
+
+	res := dto.GetSurveyResponse{
+		Survey: toSurveyResponse(template),
+	}
+
+	return res, nil
+}
+
+func (s *surveyService) CreateSurvey(ctx context.Context, req dto.CreateSurveyRequest) error {
+	valErr := s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	if req.EventID == uuid.Nil && req.SessionType == 0 {
+		return domain.ErrSurveyTargetRequired
+	}
+
+	if req.EventID != uuid.Nil {
+		_, err := s.eventRepo.FindByID(ctx, req.EventID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrEventNotFound
+			}
+
+			return err
+		}
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	template := entity.SurveyTemplate{
+		ID:          id,
+		Title:       req.Title,
+		Description: sql.NullString{String: req.Description, Valid: req.Description != ""},
+		EventID:     uuid.NullUUID{UUID: req.EventID, Valid: req.EventID != uuid.Nil},
+		SessionType: sql.NullInt16{Int16: req.SessionType, Valid: req.SessionType != 0},
+		CreatedBy:   uuid.NullUUID{UUID: req.CreatedBy, Valid: req.CreatedBy != uuid.Nil},
+	}
+
+	for position, questionReq := range req.Questions {
+		question, err := s.newSurveyQuestion(id, position, questionReq)
+		if err != nil {
+			return err
+		}
+
+		template.Questions = append(template.Questions, question)
+	}
+
+	err = s.repo.Create(ctx, &template)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyService) DeleteSurvey(ctx context.Context, query dto.DeleteSurveyQuery) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	_, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSurveyNotFound
+		}
+
+		return err
+	}
+
+	err = s.repo.Delete(ctx, query.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyService) GetSessionSurveys(
+	ctx context.Context,
+	query dto.GetSessionSurveysQuery,
+) (dto.GetSessionSurveysResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSessionSurveysResponse{}, valErr
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, query.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetSessionSurveysResponse{}, domain.ErrSessionNotFound
+		}
+
+		return dto.GetSessionSurveysResponse{}, err
+	}
+
+	templates, err := s.repo.FindApplicable(ctx, session.EventID, session.Type)
+	if err != nil {
+		return dto.GetSessionSurveysResponse{}, err
+	}
+
+	surveysResponse := []dto.SurveyResponse{}
+	for _, template := range templates {
+		detail, err := s.repo.FindByID(ctx, template.ID)
+		if err != nil {
+			return dto.GetSessionSurveysResponse{}, err
+		}
+
+		surveysResponse = append(surveysResponse, toSurveyResponse(detail))
+	}
+
+	res := dto.GetSessionSurveysResponse{
+		Surveys: surveysResponse,
+	}
+
+	return res, nil
+}
+
+func (s *surveyService) SubmitSurveyResponse(
+	ctx context.Context,
+	query dto.SubmitSurveyResponseQuery,
+	req dto.SubmitSurveyResponseRequest,
+) error {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	valErr = s.validator.Validate(req)
+	if valErr != nil {
+		return valErr
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, query.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	if session.Status != 2 {
+		return domain.ErrSessionNotAccepted
+	}
+
+	now := time.Now()
+	if session.StartAt.After(now) {
+		return domain.ErrSessionNotStarted
+	}
+
+	if session.EndAt.After(now) {
+		return domain.ErrSessionNotEnded
+	}
+
+	sessionAttendee, err := s.sessionRepo.FindSessionAttendee(ctx, query.SessionID, req.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSessionNotRegistered
+		}
+
+		return err
+	}
+
+	if sessionAttendee.Reason.Valid {
+		return domain.ErrSessionCancelled
+	}
+
+	template, err := s.repo.FindByID(ctx, query.SurveyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSurveyNotFound
+		}
+
+		return err
+	}
+
+	attachedToEvent := template.EventID.Valid && session.EventID.Valid && template.EventID.UUID == session.EventID.UUID
+	attachedToType := template.SessionType.Valid && template.SessionType.Int16 == session.Type
+	if !attachedToEvent && !attachedToType {
+		return domain.ErrSurveyNotApplicable
+	}
+
+	countResponses, err := s.repo.CountResponses(ctx, template.ID, query.SessionID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if countResponses > 0 {
+		return domain.ErrSurveyAlreadyAnswered
+	}
+
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return err
+	}
+
+	response := entity.SurveyResponse{
+		ID:         id,
+		TemplateID: template.ID,
+		SessionID:  query.SessionID,
+		UserID:     req.UserID,
+	}
+
+	answers, err := s.newSurveyAnswers(id, template.Questions, req.Answers)
+	if err != nil {
+		return err
+	}
+
+	response.Answers = answers
+
+	err = s.repo.CreateResponse(ctx, &response)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *surveyService) GetSurveyResults(
+	ctx context.Context,
+	query dto.GetSurveyResultsQuery,
+) (dto.GetSurveyResultsResponse, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetSurveyResultsResponse{}, valErr
+	}
+
+	template, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetSurveyResultsResponse{}, domain.ErrSurveyNotFound
+		}
+
+		return dto.GetSurveyResultsResponse{}, err
+	}
+
+	countResponses, err := s.repo.CountResponses(ctx, template.ID, query.SessionID, uuid.Nil)
+	if err != nil {
+		return dto.GetSurveyResultsResponse{}, err
+	}
+
+	optionCounts, err := s.repo.CountOptionAnswers(ctx, template.ID, query.SessionID)
+	if err != nil {
+		return dto.GetSurveyResultsResponse{}, err
+	}
+
+	scaleSummaries, err := s.repo.SummarizeScaleAnswers(ctx, template.ID, query.SessionID)
+	if err != nil {
+		return dto.GetSurveyResultsResponse{}, err
+	}
+
+	textAnswers, err := s.repo.FindTextAnswers(ctx, template.ID, query.SessionID)
+	if err != nil {
+		return dto.GetSurveyResultsResponse{}, err
+	}
+
+	questionsResponse := []dto.SurveyQuestionResultResponse{}
+	for _, question := range template.Questions {
+		questionResponse := dto.SurveyQuestionResultResponse{
+			ID:     question.ID,
+			Type:   question.Type,
+			Prompt: question.Prompt,
+		}
+
+		switch question.Type {
+		case 1, 2: // single choice, multiple choice
+			for _, option := range question.Options {
+				optionResponse := dto.SurveyOptionResultResponse{
+					ID:    option.ID,
+					Label: option.Label,
+				}
+
+				for _, optionCount := range optionCounts {
+					if optionCount.OptionID == option.ID {
+						optionResponse.Count = optionCount.Count
+					}
+				}
+
+				questionResponse.CountAnswers += optionResponse.Count
+				questionResponse.Options = append(questionResponse.Options, optionResponse)
+			}
+		case 3: // scale
+			for _, scaleSummary := range scaleSummaries {
+				if scaleSummary.QuestionID == question.ID {
+					average := scaleSummary.Average
+					questionResponse.CountAnswers = scaleSummary.CountAnswers
+					questionResponse.Average = &average
+				}
+			}
+		case 4: // free text
+			for _, textAnswer := range textAnswers {
+				if textAnswer.QuestionID == question.ID {
+					questionResponse.Texts = append(questionResponse.Texts, textAnswer.TextValue.String)
+				}
+			}
+
+			questionResponse.CountAnswers = int64(len(questionResponse.Texts))
+		}
+
+		questionsResponse = append(questionsResponse, questionResponse)
+	}
+
+	res := dto.GetSurveyResultsResponse{
+		Survey:         toSurveyResponse(template),
+		CountResponses: countResponses,
+		Questions:      questionsResponse,
+	}
+
+	return res, nil
+}
+
+func (s *surveyService) ExportSurveyResults(ctx context.Context, query dto.GetSurveyResultsQuery) ([]byte, error) {
+	valErr := s.validator.Validate(query)
+	if valErr != nil {
+		return nil, valErr
+	}
+
+	template, err := s.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSurveyNotFound
+		}
+
+		return nil, err
+	}
+
+	responses, err := s.repo.FindResponses(ctx, template.ID, query.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	optionLabels := map[uuid.UUID]string{}
+	header := []string{"response_id", "session_id", "user_id", "user_name", "submitted_at"}
+	for _, question := range template.Questions {
+		header = append(header, question.Prompt)
+
+		for _, option := range question.Options {
+			optionLabels[option.ID] = option.Label
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	err = writer.Write(header)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, response := range responses {
+		record := []string{
+			response.ID.String(),
+			response.SessionID.String(),
+			response.UserID.String(),
+			response.User.Name,
+			response.CreatedAt.Format(time.RFC3339),
+		}
+
+		for _, question := range template.Questions {
+			values := []string{}
+			for _, answer := range response.Answers {
+				if answer.QuestionID != question.ID {
+					continue
+				}
+
+				switch {
+				case answer.OptionID.Valid:
+					values = append(values, optionLabels[answer.OptionID.UUID])
+				case answer.ScaleValue.Valid:
+					values = append(values, strconv.Itoa(int(answer.ScaleValue.Int32)))
+				case answer.TextValue.Valid:
+					values = append(values, answer.TextValue.String)
+				}
+			}
+
+			record = append(record, strings.Join(values, "; "))
+		}
+
+		err = writer.Write(record)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *surveyService) newSurveyQuestion(
+	templateID uuid.UUID,
+	position int,
+	req dto.CreateSurveyQuestionRequest,
+) (entity.SurveyQuestion, error) {
+	id, err := s.uuidPkg.NewV7()
+	if err != nil {
+		return entity.SurveyQuestion{}, err
+	}
+
+	question := entity.SurveyQuestion{
+		ID:         id,
+		TemplateID: templateID,
+		Position:   position,
+		Type:       req.Type,
+		Prompt:     req.Prompt,
+		Required:   req.Required,
+	}
+
+	switch req.Type {
+	case 1, 2: // single choice, multiple choice
+		if len(req.Options) < 2 {
+			return entity.SurveyQuestion{}, domain.ErrInvalidSurveyQuestion
+		}
+
+		for optionPosition, label := range req.Options {
+			optionID, err := s.uuidPkg.NewV7()
+			if err != nil {
+				return entity.SurveyQuestion{}, err
+			}
+
+			question.Options = append(question.Options, entity.SurveyQuestionOption{
+				ID:         optionID,
+				QuestionID: id,
+				Position:   optionPosition,
+				Label:      label,
+			})
+		}
+	case 3: // scale
+		scaleMin, scaleMax := req.ScaleMin, req.ScaleMax
+		if scaleMin == 0 && scaleMax == 0 {
+			scaleMin, scaleMax = 1, 5
+		}
+
+		if len(req.Options) != 0 || scaleMax <= scaleMin {
+			return entity.SurveyQuestion{}, domain.ErrInvalidSurveyQuestion
+		}
+
+		question.ScaleMin = sql.NullInt32{Int32: scaleMin, Valid: true}
+		question.ScaleMax = sql.NullInt32{Int32: scaleMax, Valid: true}
+	case 4: // free text
+		if len(req.Options) != 0 {
+			return entity.SurveyQuestion{}, domain.ErrInvalidSurveyQuestion
+		}
+	}
+
+	return question, nil
+}
+
+func (s *surveyService) newSurveyAnswers(
+	responseID uuid.UUID,
+	questions []entity.SurveyQuestion,
+	reqs []dto.SurveyAnswerRequest,
+) ([]entity.SurveyAnswer, error) {
+	answered := map[uuid.UUID]bool{}
+	answers := []entity.SurveyAnswer{}
+
+	for _, req := range reqs {
+		var question *entity.SurveyQuestion
+		for i := range questions {
+			if questions[i].ID == req.QuestionID {
+				question = &questions[i]
+			}
+		}
+
+		if question == nil || answered[question.ID] {
+			return nil, domain.ErrInvalidSurveyAnswer
+		}
+
+		answered[question.ID] = true
+
+		answer := entity.SurveyAnswer{
+			ResponseID: responseID,
+			QuestionID: question.ID,
+		}
+
+		switch question.Type {
+		case 1, 2: // single choice, multiple choice
+			if len(req.OptionIDs) == 0 || (question.Type == 1 && len(req.OptionIDs) > 1) {
+				return nil, domain.ErrInvalidSurveyAnswer
+			}
+
+			chosen := map[uuid.UUID]bool{}
+			for _, optionID := range req.OptionIDs {
+				if chosen[optionID] || !hasSurveyOption(question, optionID) {
+					return nil, domain.ErrInvalidSurveyAnswer
+				}
+
+				chosen[optionID] = true
+
+				id, err := s.uuidPkg.NewV7()
+				if err != nil {
+					return nil, err
+				}
+
+				optionAnswer := answer
+				optionAnswer.ID = id
+				optionAnswer.OptionID = uuid.NullUUID{UUID: optionID, Valid: true}
+				answers = append(answers, optionAnswer)
+			}
+
+			continue
+		case 3: // scale
+			if req.ScaleValue == nil ||
+				*req.ScaleValue < question.ScaleMin.Int32 ||
+				*req.ScaleValue > question.ScaleMax.Int32 {
+				return nil, domain.ErrInvalidSurveyAnswer
+			}
+
+			answer.ScaleValue = sql.NullInt32{Int32: *req.ScaleValue, Valid: true}
+		case 4: // free text
+			text := strings.TrimSpace(req.Text)
+			if text == "" {
+				return nil, domain.ErrInvalidSurveyAnswer
+			}
+
+			answer.TextValue = sql.NullString{String: text, Valid: true}
+		}
+
+		id, err := s.uuidPkg.NewV7()
+		if err != nil {
+			return nil, err
+		}
+
+		answer.ID = id
+		answers = append(answers, answer)
+	}
+
+	for _, question := range questions {
+		if question.Required && !answered[question.ID] {
+			return nil, domain.ErrSurveyQuestionUnanswered
+		}
+	}
+
+	return answers, nil
+}
+
+func hasSurveyOption(question *entity.SurveyQuestion, optionID uuid.UUID) bool {
+	for _, option := range question.Options {
+		if option.ID == optionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toSurveyResponse(template *entity.SurveyTemplate) dto.SurveyResponse {
+	surveyResponse := dto.SurveyResponse{
+		ID:          template.ID,
+		Title:       template.Title,
+		Description: template.Description.String,
+		EventID:     template.EventID,
+		SessionType: template.SessionType.Int16,
+		CreatedAt:   template.CreatedAt,
+	}
+
+	for _, question := range template.Questions {
+		questionResponse := dto.SurveyQuestionResponse{
+			ID:       question.ID,
+			Type:     question.Type,
+			Prompt:   question.Prompt,
+			Required: question.Required,
+		}
+
+		if question.ScaleMin.Valid {
+			questionResponse.ScaleMin = &question.ScaleMin.Int32
+		}
+
+		if question.ScaleMax.Valid {
+			questionResponse.ScaleMax = &question.ScaleMax.Int32
+		}
+
+		for _, option := range question.Options {
+			questionResponse.Options = append(questionResponse.Options, dto.SurveyQuestionOptionResponse{
+				ID:    option.ID,
+				Label: option.Label,
+			})
+		}
+
+		surveyResponse.Questions = append(surveyResponse.Questions, questionResponse)
+	}
+
+	return surveyResponse
+}
+
+func NewSurveyService(
+	repo contracts.SurveyRepository,
+	sessionRepo contracts.SessionRepository,
+	eventRepo contracts.EventRepository,
+	validator validator.ValidatorInterface,
+	uuidPkg uuidPkg.CustomUUIDInterface,
+) contracts.SurveyService {
+	return &surveyService{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		eventRepo:   eventRepo,
+		validator:   validator,
+		uuidPkg:     uuidPkg,
+	}
+}
@@ -22,10 +22,18 @@ func InitUserController(router fiber.Router, userService contracts.UserService,
 	userRouter := router.Group("/users")
 
 	userRouter.Get("/", middleware.RequireAuth(), middleware.RequirePermission([]int16{3}), controller.GetUsers)
+	userRouter.Get("/trash", middleware.RequireAuth(), middleware.RequirePermission([]int16{3}), controller.GetDeletedUsers)
 	userRouter.Get("/:id", middleware.RequireAuth(), controller.GetUser)
+	userRouter.Get("/:id/profile", middleware.RequireAuth(), controller.GetUserProfile)
 	userRouter.Post("/", middleware.RequireAuth(), middleware.RequirePermission([]int16{3}), controller.CreateUser)
 	userRouter.Patch("/", middleware.RequireAuth(), controller.UpdateUser)
 	userRouter.Delete("/:id", middleware.RequireAuth(), middleware.RequirePermission([]int16{3}), controller.DeleteUser)
+	userRouter.Post(
+		"/:id/restore",
+		middleware.RequireAuth(),
+		middleware.RequirePermission([]int16{3}),
+		controller.RestoreUser,
+	)
 }
 
 func (u *userController) GetUsers(c *fiber.Ctx) error {
@@ -48,6 +56,14 @@ func (u *userController) GetUser(c *fiber.Ctx) error {
 		return err
 	}
 
+	claims, ok := c.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.ViewerID = claims.UserID
+	query.ViewerRole = claims.Role
+
 	user, err := u.userService.GetUser(c.Context(), query)
 	if err != nil {
 		return err
@@ -56,6 +72,28 @@ func (u *userController) GetUser(c *fiber.Ctx) error {
 	return response.SendResponse(c, fiber.StatusOK, user)
 }
 
+func (u *userController) GetUserProfile(c *fiber.Ctx) error {
+	var query dto.GetUserProfileQuery
+	if err := c.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	claims, ok := c.Locals("claims").(jwt.Claims)
+	if !ok {
+		return domain.ErrClaimsNotFound
+	}
+
+	query.ViewerID = claims.UserID
+	query.ViewerRole = claims.Role
+
+	profile, err := u.userService.GetUserProfile(c.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(c, fiber.StatusOK, profile)
+}
+
 func (u *userController) CreateUser(c *fiber.Ctx) error {
 	var req dto.CreateUserRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -104,3 +142,31 @@ func (u *userController) DeleteUser(c *fiber.Ctx) error {
 
 	return response.SendResponse(c, fiber.StatusOK, nil)
 }
+
+func (u *userController) GetDeletedUsers(c *fiber.Ctx) error {
+	var query dto.GetDeletedUsersQuery
+	if err := c.QueryParser(&query); err != nil {
+		return err
+	}
+
+	users, err := u.userService.GetDeletedUsers(c.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(c, fiber.StatusOK, users)
+}
+
+func (u *userController) RestoreUser(c *fiber.Ctx) error {
+	var query dto.RestoreUserQuery
+	if err := c.ParamsParser(&query); err != nil {
+		return err
+	}
+
+	err := u.userService.RestoreUser(c.Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return response.SendResponse(c, fiber.StatusOK, nil)
+}
@@ -2,11 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -31,7 +37,11 @@ func (u *userRepository) Create(ctx context.Context, user *entity.User) error {
 func (u *userRepository) Update(ctx context.Context, user *entity.User) error {
 	_, err := u.db.NamedExecContext(ctx, `
 		UPDATE users
-		SET name = :name, email = :email, password = :password, role = :role
+		SET name = :name, email = :email, password = :password, role = :role, interests = :interests,
+			time_zone = :time_zone, bio = :bio, affiliation = :affiliation, job_title = :job_title,
+			email_visibility = :email_visibility, bio_visibility = :bio_visibility,
+			affiliation_visibility = :affiliation_visibility, job_title_visibility = :job_title_visibility,
+			links_visibility = :links_visibility
 		WHERE id = :id
 		`, user,
 	)
@@ -42,15 +52,171 @@ func (u *userRepository) Update(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
-func (u *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := u.db.ExecContext(ctx, "DELETE FROM users WHERE id = $1", id)
+// Moves the user to the trash and gives up their seats in sessions that
+// haven't started yet, declining their pending registration requests, in a
+// single transaction. Past attendance and reviews stay. Returns the sessions
+// whose seats were given up.
+func (u *userRepository) Delete(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	tx, err := u.db.BeginTxx(ctx, nil)
 	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(
+		ctx,
+		"UPDATE users SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL",
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs := []uuid.UUID{}
+	err = tx.SelectContext(ctx, &sessionIDs, `
+		DELETE FROM session_attendees USING sessions
+		WHERE sessions.id = session_attendees.session_id AND session_attendees.user_id = $1
+			AND sessions.start_at > CURRENT_TIMESTAMP
+		RETURNING session_attendees.session_id
+		`, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE registration_requests
+		SET status = 3, reason = 'Account deleted', decided_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND status = 1
+		`, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionIDs, nil
+}
+
+func (u *userRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	_, err := u.db.ExecContext(ctx, "UPDATE users SET deleted_at = NULL WHERE id = $1 AND anonymized_at IS NULL", id)
+	if err != nil {
+		// someone registered with the email since the check before restoring
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return domain.ErrEmailAlreadyExists
+		}
+
 		return err
 	}
 
 	return nil
 }
 
+// Anonymizes users that have been in the trash since before deletedBefore.
+// Deleting them would cascade into other users' data, like the reviews and
+// attendance of the sessions they proposed, so the rows stay without anything
+// personal in them and can't be restored anymore.
+func (u *userRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	purgeable := "SELECT id FROM users WHERE deleted_at IS NOT NULL AND deleted_at < $1 AND anonymized_at IS NULL"
+
+	// nobody else sees these, so they go
+	_, err = tx.ExecContext(ctx, "DELETE FROM user_links WHERE user_id IN ("+purgeable+")", deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM notifications WHERE user_id IN ("+purgeable+")", deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE users
+		SET name = 'Deleted user', email = 'deleted-' || id || '@deleted.invalid', password = '',
+			image_uri = NULL, interests = 0, time_zone = NULL, bio = NULL, affiliation = NULL, job_title = NULL,
+			email_visibility = 2, bio_visibility = 2, affiliation_visibility = 2, job_title_visibility = 2,
+			links_visibility = 2, anonymized_at = CURRENT_TIMESTAMP
+		WHERE id IN (`+purgeable+`)
+		`, deletedBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (u *userRepository) FindAllDeleted(ctx context.Context, limit, offset int) ([]entity.User, error) {
+	users := []entity.User{}
+	err := u.db.SelectContext(
+		ctx,
+		&users,
+		`SELECT * FROM users WHERE deleted_at IS NOT NULL AND anonymized_at IS NULL
+		ORDER BY deleted_at DESC LIMIT $1 OFFSET $2`,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (u *userRepository) CountDeleted(ctx context.Context) (int64, error) {
+	var count int64
+	err := u.db.GetContext(
+		ctx,
+		&count,
+		"SELECT COUNT(*) FROM users WHERE deleted_at IS NOT NULL AND anonymized_at IS NULL",
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (u *userRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	var user entity.User
+	err := u.db.GetContext(
+		ctx,
+		&user,
+		"SELECT * FROM users WHERE id = $1 AND deleted_at IS NOT NULL AND anonymized_at IS NULL",
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (u *userRepository) FindAll(
 	ctx context.Context,
 	limit int,
@@ -59,9 +225,10 @@ func (u *userRepository) FindAll(
 	sortOrder string,
 	search string,
 	role int16,
+	after *cursor.Cursor,
 ) ([]entity.User, error) {
 	users := []entity.User{}
-	query := "SELECT * FROM users WHERE 1=1"
+	query := "SELECT * FROM users WHERE deleted_at IS NULL"
 	args := []interface{}{}
 
 	if search != "" {
@@ -74,7 +241,12 @@ func (u *userRepository) FindAll(
 		args = append(args, role)
 	}
 
-	query += fmt.Sprintf(" ORDER BY %s %s LIMIT $%d OFFSET $%d", sortBy, sortOrder, len(args)+1, len(args)+2)
+	var keyset string
+	keyset, args = after.Where(sortBy, "id", args)
+	query += keyset
+
+	order := after.Order(strings.EqualFold(sortOrder, "desc"))
+	query += fmt.Sprintf(" ORDER BY %s %s, id %s LIMIT $%d OFFSET $%d", sortBy, order, order, len(args)+1, len(args)+2)
 	args = append(args, limit, offset)
 
 	err := u.db.SelectContext(ctx, &users, query, args...)
@@ -87,7 +259,7 @@ func (u *userRepository) FindAll(
 
 func (u *userRepository) Count(ctx context.Context, search string, role int16) (int64, error) {
 	var count int64
-	query := "SELECT COUNT(*) FROM users WHERE 1=1"
+	query := "SELECT COUNT(*) FROM users WHERE deleted_at IS NULL"
 	args := []interface{}{}
 
 	if search != "" {
@@ -110,7 +282,7 @@ func (u *userRepository) Count(ctx context.Context, search string, role int16) (
 
 func (u *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	err := u.db.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1", email)
+	err := u.db.GetContext(ctx, &user, "SELECT * FROM users WHERE email = $1 AND deleted_at IS NULL", email)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +292,7 @@ func (u *userRepository) FindByEmail(ctx context.Context, email string) (*entity
 
 func (u *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
-	err := u.db.GetContext(ctx, &user, "SELECT * FROM users WHERE id = $1", id)
+	err := u.db.GetContext(ctx, &user, "SELECT * FROM users WHERE id = $1 AND deleted_at IS NULL", id)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +300,46 @@ func (u *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Us
 	return &user, nil
 }
 
+func (u *userRepository) FindLinks(ctx context.Context, userID uuid.UUID) ([]entity.UserLink, error) {
+	links := []entity.UserLink{}
+	err := u.db.SelectContext(ctx, &links, "SELECT * FROM user_links WHERE user_id = $1 ORDER BY position ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// Swaps all links of a user for the given ones in a single transaction.
+func (u *userRepository) ReplaceLinks(ctx context.Context, userID uuid.UUID, links []entity.UserLink) error {
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM user_links WHERE user_id = $1", userID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		_, err = tx.NamedExecContext(ctx, `
+			INSERT INTO user_links
+			(id, user_id, position, label, url)
+			VALUES (:id, :user_id, :position, :label, :url)
+			`, link,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func NewUserRepository(db *sqlx.DB) contracts.UserRepository {
 	return &userRepository{
 		db: db,
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
+	"github.com/google/uuid"
+)
+
+// The user themselves, event coordinators and admins see every field, others
+// only the public ones.
+func canSeeProfileField(user *entity.User, visibility int16, viewerID uuid.UUID, viewerRole int16) bool {
+	return visibility == 1 || user.ID == viewerID || viewerRole == 2 || viewerRole == 3
+}
+
+func (u *userService) GetUserProfile(
+	ctx context.Context,
+	query dto.GetUserProfileQuery,
+) (dto.GetUserProfileResponse, error) {
+	valErr := u.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetUserProfileResponse{}, valErr
+	}
+
+	user, err := u.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.GetUserProfileResponse{}, domain.ErrUserNotFound
+		}
+
+		return dto.GetUserProfileResponse{}, err
+	}
+
+	profile := dto.UserProfileResponse{
+		ID:               user.ID,
+		Name:             user.Name,
+		Role:             user.Role,
+		UpcomingSessions: []dto.SpeakerSessionResponse{},
+		PastSessions:     []dto.SpeakerSessionResponse{},
+	}
+
+	if user.ImageURI.Valid {
+		profile.ImageURI = &user.ImageURI.String
+	}
+
+	if canSeeProfileField(user, user.EmailVisibility, query.ViewerID, query.ViewerRole) {
+		profile.Email = user.Email
+	}
+
+	if canSeeProfileField(user, user.BioVisibility, query.ViewerID, query.ViewerRole) {
+		profile.Bio = user.Bio.String
+	}
+
+	if canSeeProfileField(user, user.AffiliationVisibility, query.ViewerID, query.ViewerRole) {
+		profile.Affiliation = user.Affiliation.String
+	}
+
+	if canSeeProfileField(user, user.JobTitleVisibility, query.ViewerID, query.ViewerRole) {
+		profile.JobTitle = user.JobTitle.String
+	}
+
+	if canSeeProfileField(user, user.LinksVisibility, query.ViewerID, query.ViewerRole) {
+		links, err := u.repo.FindLinks(ctx, user.ID)
+		if err != nil {
+			return dto.GetUserProfileResponse{}, err
+		}
+
+		for _, link := range links {
+			profile.Links = append(profile.Links, dto.UserLinkResponse{
+				Label: link.Label,
+				URL:   link.URL,
+			})
+		}
+	}
+
+	if user.ID == query.ViewerID {
+		profile.Privacy = &dto.ProfilePrivacyResponse{
+			Email:       enums.ProfileFieldVisibility[user.EmailVisibility],
+			Bio:         enums.ProfileFieldVisibility[user.BioVisibility],
+			Affiliation: enums.ProfileFieldVisibility[user.AffiliationVisibility],
+			JobTitle:    enums.ProfileFieldVisibility[user.JobTitleVisibility],
+			Links:       enums.ProfileFieldVisibility[user.LinksVisibility],
+		}
+	}
+
+	// unlisted and invite-only sessions stay off the profile for other users
+	includeHidden := user.ID == query.ViewerID || query.ViewerRole == 2 || query.ViewerRole == 3
+
+	sessions, err := u.sessionRepo.FindSpeakerSessions(ctx, user.ID, includeHidden)
+	if err != nil {
+		return dto.GetUserProfileResponse{}, err
+	}
+
+	now := time.Now()
+	for _, session := range sessions {
+		sessionResponse := dto.SpeakerSessionResponse{
+			ID:           session.ID,
+			Title:        session.Title,
+			Tags:         session.TagsArray(),
+			StartAt:      session.StartAt.UTC(),
+			EndAt:        session.EndAt.UTC(),
+			CountRatings: session.CountRatings,
+		}
+
+		// ratings are stored normalized to 0-1 and shown on the usual 1-5 scale
+		if session.AverageRating.Valid {
+			rating := math.Round((1+4*session.AverageRating.Float64)*10) / 10
+			sessionResponse.AverageRating = &rating
+		}
+
+		if session.EndAt.After(now) {
+			profile.UpcomingSessions = append(profile.UpcomingSessions, sessionResponse)
+		} else {
+			profile.PastSessions = append(profile.PastSessions, sessionResponse)
+		}
+	}
+
+	// sessions come latest first, upcoming ones read better soonest first
+	slices.Reverse(profile.UpcomingSessions)
+
+	res := dto.GetUserProfileResponse{
+		Profile: profile,
+	}
+
+	return res, nil
+}
+
+// Applies the profile part of an update to the user. Links are returned
+// separately since they live in their own table, nil when left unchanged.
+func (u *userService) updateProfile(user *entity.User, req dto.UpdateUserRequest) ([]entity.UserLink, error) {
+	if req.Bio != nil {
+		user.Bio = sql.NullString{String: *req.Bio, Valid: *req.Bio != ""}
+	}
+
+	if req.Affiliation != nil {
+		user.Affiliation = sql.NullString{String: *req.Affiliation, Valid: *req.Affiliation != ""}
+	}
+
+	if req.JobTitle != nil {
+		user.JobTitle = sql.NullString{String: *req.JobTitle, Valid: *req.JobTitle != ""}
+	}
+
+	if req.Privacy != nil {
+		setProfileFieldVisibility(&user.EmailVisibility, req.Privacy.Email)
+		setProfileFieldVisibility(&user.BioVisibility, req.Privacy.Bio)
+		setProfileFieldVisibility(&user.AffiliationVisibility, req.Privacy.Affiliation)
+		setProfileFieldVisibility(&user.JobTitleVisibility, req.Privacy.JobTitle)
+		setProfileFieldVisibility(&user.LinksVisibility, req.Privacy.Links)
+	}
+
+	if req.Links == nil {
+		return nil, nil
+	}
+
+	links := []entity.UserLink{}
+	for position, linkReq := range *req.Links {
+		id, err := u.uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, entity.UserLink{
+			ID:       id,
+			UserID:   user.ID,
+			Position: position,
+			Label:    linkReq.Label,
+			URL:      linkReq.URL,
+		})
+	}
+
+	return links, nil
+}
+
+// A setting of 0 was left out of the request and keeps the current one.
+func setProfileFieldVisibility(visibility *int16, setting int16) {
+	if setting != 0 {
+		*visibility = setting
+	}
+}
@@ -4,21 +4,28 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/dto"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/enums"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/bcrypt"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/cursor"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
 )
 
 type userService struct {
-	repo      contracts.UserRepository
-	validator validator.ValidatorInterface
-	uuid      uuid.CustomUUIDInterface
-	bcrypt    bcrypt.CustomBcryptInterface
+	repo           contracts.UserRepository
+	sessionRepo    contracts.SessionRepository
+	sessionService contracts.SessionService
+	validator      validator.ValidatorInterface
+	uuid           uuid.CustomUUIDInterface
+	bcrypt         bcrypt.CustomBcryptInterface
 }
 
 func (u *userService) CreateUser(ctx context.Context, req dto.CreateUserRequest) error {
@@ -81,6 +88,20 @@ func (u *userService) UpdateUser(ctx context.Context, req dto.UpdateUserRequest)
 		user.Name = req.Name
 	}
 
+	if req.TimeZone != nil {
+		user.TimeZone = sql.NullString{String: *req.TimeZone, Valid: *req.TimeZone != ""}
+	}
+
+	// an empty list clears the interests, leaving it out keeps them
+	if req.Interests != nil {
+		user.Interests = 0
+		for i, tag := range enums.ShortSessionTag {
+			if slices.Contains(req.Interests, tag) {
+				user.Interests |= 1 << (len(enums.ShortSessionTag) - 1 - i)
+			}
+		}
+	}
+
 	if req.Password != "" {
 		hashedPassword, hashErr := u.bcrypt.Hash(req.Password)
 		if hashErr != nil {
@@ -90,11 +111,23 @@ func (u *userService) UpdateUser(ctx context.Context, req dto.UpdateUserRequest)
 		user.Password = hashedPassword
 	}
 
+	links, err := u.updateProfile(user, req)
+	if err != nil {
+		return err
+	}
+
 	err = u.repo.Update(ctx, user)
 	if err != nil {
 		return err
 	}
 
+	if links != nil {
+		err = u.repo.ReplaceLinks(ctx, user.ID, links)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -117,11 +150,14 @@ func (u *userService) DeleteUser(ctx context.Context, query dto.DeleteUserQuery)
 		return domain.ErrCannotDeleteAdmin
 	}
 
-	err = u.repo.Delete(ctx, query.ID)
+	sessionIDs, err := u.repo.Delete(ctx, query.ID)
 	if err != nil {
 		return err
 	}
 
+	// published once the seats are actually free
+	u.sessionService.PublishSessionSeats(ctx, sessionIDs)
+
 	return nil
 }
 
@@ -141,10 +177,15 @@ func (u *userService) GetUser(ctx context.Context, query dto.GetUserQuery) (dto.
 	}
 
 	userResponse := dto.UserResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Role:  user.Role,
+		ID:        user.ID,
+		Name:      user.Name,
+		Role:      user.Role,
+		Interests: user.InterestsArray(),
+		TimeZone:  user.TimeZone.String,
+	}
+
+	if canSeeProfileField(user, user.EmailVisibility, query.ViewerID, query.ViewerRole) {
+		userResponse.Email = user.Email
 	}
 
 	if user.ImageURI.Valid {
@@ -180,22 +221,126 @@ func (u *userService) GetUsers(ctx context.Context, query dto.GetUsersQuery) (dt
 		query.SortOrder = "asc"
 	}
 
+	desc := strings.EqualFold(query.SortOrder, "desc")
+
+	var after *cursor.Cursor
+	if query.Cursor != "" {
+		var err error
+		after, err = cursor.Decode(query.Cursor, query.SortBy, desc)
+		if err != nil {
+			return dto.GetUsersResponse{}, domain.ErrInvalidCursor
+		}
+	}
+
+	offset := (query.Page - 1) * query.Limit
+	if after != nil {
+		offset = 0
+	}
+
 	users, err := u.repo.FindAll(
 		ctx,
-		query.Limit,
-		(query.Page-1)*query.Limit,
+		query.Limit+1, // the extra row tells whether there is a next page
+		offset,
 		query.SortBy,
 		query.SortOrder,
 		query.Search,
 		query.Role,
+		after,
 	)
 	if err != nil {
 		return dto.GetUsersResponse{}, err
 	}
 
-	totalData, err := u.repo.Count(ctx, query.Search, query.Role)
+	users, nextCursor, prevCursor := cursor.Page(
+		users,
+		query.Limit,
+		after,
+		query.SortBy,
+		desc,
+		func(user entity.User) (string, string) {
+			return userSortValue(user, query.SortBy), user.ID.String()
+		},
+	)
+
+	meta := dto.PaginationResponse{
+		Limit:      query.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+
+	if after == nil {
+		meta.Page = query.Page
+	}
+
+	// counting is the expensive part, so cursor clients have to ask for it
+	includeTotal := after == nil
+	if query.IncludeTotal != nil {
+		includeTotal = *query.IncludeTotal
+	}
+
+	if includeTotal {
+		totalData, err := u.repo.Count(ctx, query.Search, query.Role)
+		if err != nil {
+			return dto.GetUsersResponse{}, err
+		}
+
+		totalPage := int(totalData) / query.Limit
+		if int(totalData)%query.Limit != 0 {
+			totalPage++
+		}
+
+		meta.TotalData = &totalData
+		meta.TotalPage = &totalPage
+	}
+
+	res := dto.GetUsersResponse{
+		Users: make([]dto.UserResponse, 0, len(users)),
+		Meta:  meta,
+	}
+
+	for _, user := range users {
+		userResponse := dto.UserResponse{
+			ID:    user.ID,
+			Name:  user.Name,
+			Email: user.Email,
+			Role:  user.Role,
+		}
+
+		if user.ImageURI.Valid {
+			userResponse.ImageURI = &user.ImageURI.String
+		}
+
+		res.Users = append(res.Users, userResponse)
+	}
+
+	return res, nil
+}
+
+func (u *userService) GetDeletedUsers(
+	ctx context.Context,
+	query dto.GetDeletedUsersQuery,
+) (dto.GetDeletedUsersResponse, error) {
+	valErr := u.validator.Validate(query)
+	if valErr != nil {
+		return dto.GetDeletedUsersResponse{}, valErr
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+
+	if query.Limit < 1 {
+		query.Limit = 10
+	}
+
+	users, err := u.repo.FindAllDeleted(ctx, query.Limit, (query.Page-1)*query.Limit)
 	if err != nil {
-		return dto.GetUsersResponse{}, err
+		return dto.GetDeletedUsersResponse{}, err
+	}
+
+	totalData, err := u.repo.CountDeleted(ctx)
+	if err != nil {
+		return dto.GetDeletedUsersResponse{}, err
 	}
 
 	totalPage := int(totalData) / query.Limit
@@ -204,23 +349,24 @@ func (u *userService) GetUsers(ctx context.Context, query dto.GetUsersQuery) (dt
 	}
 
 	meta := dto.PaginationResponse{
-		TotalData: totalData,
-		TotalPage: totalPage,
+		TotalData: &totalData,
+		TotalPage: &totalPage,
 		Page:      query.Page,
 		Limit:     query.Limit,
 	}
 
-	res := dto.GetUsersResponse{
+	res := dto.GetDeletedUsersResponse{
 		Users: make([]dto.UserResponse, 0, len(users)),
 		Meta:  meta,
 	}
 
 	for _, user := range users {
 		userResponse := dto.UserResponse{
-			ID:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.Role,
+			ID:        user.ID,
+			Name:      user.Name,
+			Email:     user.Email,
+			Role:      user.Role,
+			DeletedAt: &user.DeletedAt.Time,
 		}
 
 		if user.ImageURI.Valid {
@@ -233,16 +379,71 @@ func (u *userService) GetUsers(ctx context.Context, query dto.GetUsersQuery) (dt
 	return res, nil
 }
 
+func (u *userService) RestoreUser(ctx context.Context, query dto.RestoreUserQuery) error {
+	valErr := u.validator.Validate(query)
+	if valErr != nil {
+		return valErr
+	}
+
+	user, err := u.repo.FindDeletedByID(ctx, query.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUserNotFound
+		}
+
+		return err
+	}
+
+	// the email may have been taken by a new account while this one was in the trash
+	_, err = u.repo.FindByEmail(ctx, user.Email)
+	if err == nil {
+		return domain.ErrEmailAlreadyExists
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	err = u.repo.Restore(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Sort value of a user as a cursor stores it.
+func userSortValue(user entity.User, sortBy string) string {
+	switch sortBy {
+	case "name":
+		return user.Name
+	case "email":
+		return user.Email
+	case "role":
+		return strconv.Itoa(int(user.Role))
+	case "created_at":
+		return user.CreatedAt
+	case "updated_at":
+		return user.UpdatedAt
+	}
+
+	return user.ID.String()
+}
+
 func NewUserService(
 	repo contracts.UserRepository,
+	sessionRepo contracts.SessionRepository,
+	sessionService contracts.SessionService,
 	validator validator.ValidatorInterface,
 	uuid uuid.CustomUUIDInterface,
 	bcrypt bcrypt.CustomBcryptInterface,
 ) contracts.UserService {
 	return &userService{
-		repo:      repo,
-		validator: validator,
-		uuid:      uuid,
-		bcrypt:    bcrypt,
+		repo:           repo,
+		sessionRepo:    sessionRepo,
+		sessionService: sessionService,
+		validator:      validator,
+		uuid:           uuid,
+		bcrypt:         bcrypt,
 	}
 }
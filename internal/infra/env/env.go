@@ -1,6 +1,9 @@
 package env
 
 import (
+	"errors"
+	"io/fs"
+	"reflect"
 	"time"
 
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
@@ -8,19 +11,27 @@ import (
 )
 
 type Env struct {
-	AppEnv           string        `mapstructure:"APP_ENV"`
-	AppURL           string        `mapstructure:"APP_URL"`
-	AppPort          string        `mapstructure:"APP_PORT"`
-	APIKey           string        `mapstructure:"API_KEY"`
-	DBHost           string        `mapstructure:"DB_HOST"`
-	DBPort           string        `mapstructure:"DB_PORT"`
-	DBUser           string        `mapstructure:"DB_USER"`
-	DBPass           string        `mapstructure:"DB_PASS"`
-	DBName           string        `mapstructure:"DB_NAME"`
-	JwtSecretKey     string        `mapstructure:"JWT_SECRET_KEY"`
-	JwtExpTime       time.Duration `mapstructure:"JWT_EXP_TIME"`
-	SiamClientID     string        `mapstructure:"SIAM_CLIENT_ID"`
-	SiamClientSecret string        `mapstructure:"SIAM_CLIENT_SECRET"`
+	AppEnv                string        `mapstructure:"APP_ENV"`
+	AppURL                string        `mapstructure:"APP_URL"`
+	AppPort               string        `mapstructure:"APP_PORT"`
+	APIKey                string        `mapstructure:"API_KEY"`
+	DBHost                string        `mapstructure:"DB_HOST"`
+	DBPort                string        `mapstructure:"DB_PORT"`
+	DBUser                string        `mapstructure:"DB_USER"`
+	DBPass                string        `mapstructure:"DB_PASS"`
+	DBName                string        `mapstructure:"DB_NAME"`
+	JwtSecretKey          string        `mapstructure:"JWT_SECRET_KEY"`
+	JwtExpTime            time.Duration `mapstructure:"JWT_EXP_TIME"`
+	SiamClientID          string        `mapstructure:"SIAM_CLIENT_ID"`
+	SiamClientSecret      string        `mapstructure:"SIAM_CLIENT_SECRET"`
+	ReviewEditWindow      time.Duration `mapstructure:"REVIEW_EDIT_WINDOW"`
+	PubSubDriver          string        `mapstructure:"PUBSUB_DRIVER"`
+	TrashRetentionPeriod  time.Duration `mapstructure:"TRASH_RETENTION_PERIOD"`
+	TrashPurgeInterval    time.Duration `mapstructure:"TRASH_PURGE_INTERVAL"`
+	DefaultTimeZone       string        `mapstructure:"DEFAULT_TIME_ZONE"`
+	PublicCacheMaxAge     time.Duration `mapstructure:"PUBLIC_CACHE_MAX_AGE"`
+	PublicRateLimit       int           `mapstructure:"PUBLIC_RATE_LIMIT"`
+	PublicRateLimitWindow time.Duration `mapstructure:"PUBLIC_RATE_LIMIT_WINDOW"`
 }
 
 var AppEnv = getEnv()
@@ -30,7 +41,16 @@ func getEnv() *Env {
 
 	viper.SetConfigFile("./config/.env")
 
-	if err := viper.ReadInConfig(); err != nil {
+	viper.SetDefault("REVIEW_EDIT_WINDOW", "168h")
+
+	// every key can also come from the environment, so the config file is
+	// optional, e.g. in containers or unit tests
+	viper.AutomaticEnv()
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(*env)) {
+		_ = viper.BindEnv(field.Tag.Get("mapstructure"))
+	}
+
+	if err := viper.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
 		log.Fatal(log.LogInfo{
 			"error": err.Error(),
 		}, "[ENV][getEnv] failed to read config file")
@@ -42,6 +62,12 @@ func getEnv() *Env {
 		}, "[ENV][getEnv] failed to unmarshal to struct")
 	}
 
+	if env.ReviewEditWindow <= 0 {
+		log.Fatal(log.LogInfo{
+			"review_edit_window": env.ReviewEditWindow.String(),
+		}, "[ENV][getEnv] REVIEW_EDIT_WINDOW must be a positive duration")
+	}
+
 	switch env.AppEnv {
 	case "development":
 		log.Info(nil, "Application is running on development mode")
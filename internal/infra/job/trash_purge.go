@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	sessionRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/session/repository"
+	userRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/user/repository"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/jmoiron/sqlx"
+)
+
+// Permanently deletes sessions and anonymizes users that have been in the trash
+// for longer than the retention period.
+func StartTrashPurge(db *sqlx.DB) {
+	interval := env.AppEnv.TrashPurgeInterval
+	retention := env.AppEnv.TrashRetentionPeriod
+	if interval <= 0 || retention <= 0 {
+		log.Info(nil, "[JOB][StartTrashPurge] trash purge is disabled")
+
+		return
+	}
+
+	sessionRepository := sessionRepo.NewSessionRepository(db)
+	userRepository := userRepo.NewUserRepository(db)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			ctx := context.Background()
+			deletedBefore := time.Now().Add(-retention)
+
+			countSessions, err := sessionRepository.Purge(ctx, deletedBefore)
+			if err != nil {
+				log.Error(log.LogInfo{
+					"error": err.Error(),
+				}, "[JOB][StartTrashPurge] failed to purge sessions")
+			}
+
+			countUsers, err := userRepository.Purge(ctx, deletedBefore)
+			if err != nil {
+				log.Error(log.LogInfo{
+					"error": err.Error(),
+				}, "[JOB][StartTrashPurge] failed to purge users")
+			}
+
+			if countSessions > 0 || countUsers > 0 {
+				log.Info(log.LogInfo{
+					"sessions": countSessions,
+					"users":    countUsers,
+				}, "[JOB][StartTrashPurge] purged trash")
+			}
+
+			<-ticker.C
+		}
+	}()
+}
@@ -1,21 +1,47 @@
 package server
 
 import (
+	"time"
+
 	authController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/auth/controller"
 	authRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/auth/repository"
 	authSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/auth/service"
+	catalogueController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/catalogue/controller"
+	catalogueRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/catalogue/repository"
+	catalogueSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/catalogue/service"
+	eventController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/event/controller"
+	eventRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/event/repository"
+	eventSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/event/service"
+	feedController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/feed/controller"
+	feedSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/feed/service"
+	notificationController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/notification/controller"
+	notificationRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/notification/repository"
+	notificationSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/notification/service"
+	pollController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/poll/controller"
+	pollRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/poll/repository"
+	pollSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/poll/service"
+	questionController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/question/controller"
+	questionRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/question/repository"
+	questionSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/question/service"
 	sessionController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/session/controller"
 	sessionRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/session/repository"
 	sessionSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/session/service"
+	surveyController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/survey/controller"
+	surveyRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/survey/repository"
+	surveySvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/survey/service"
 	userController "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/user/controller"
 	userRepo "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/user/repository"
 	userSvc "github.com/ahargunyllib/freepass-be-bcc-2025/internal/app/user/service"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/middlewares"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/bcrypt"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/feed"
 	errorhandler "github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/error_handler"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/helpers/http/response"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/pubsub"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/spreadsheet"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/timezone"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/uuid"
 	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/validator"
 	"github.com/bytedance/sonic"
@@ -46,6 +72,15 @@ func NewHTTPServer() HTTPServer {
 
 	app := fiber.New(config)
 
+	// query times may carry an offset or a zone name, see timezone.Parse
+	fiber.SetParserDecoder(fiber.ParserConfig{
+		IgnoreUnknownKeys: true,
+		ZeroEmpty:         true,
+		ParserType: []fiber.ParserType{
+			{Customtype: time.Time{}, Converter: timezone.QueryConverter},
+		},
+	})
+
 	return &httpServer{
 		app: app,
 	}
@@ -82,6 +117,9 @@ func (s *httpServer) MountRoutes(db *sqlx.DB) {
 	uuid := uuid.UUID
 	validator := validator.Validator
 	jwt := jwt.Jwt
+	pubsub := pubsub.PubSub
+	spreadsheet := spreadsheet.Spreadsheet
+	feed := feed.Feed
 
 	s.app.Get("/", func(c *fiber.Ctx) error {
 		return response.SendResponse(c, fiber.StatusOK, "Freepass BE BCC 2025")
@@ -97,16 +135,51 @@ func (s *httpServer) MountRoutes(db *sqlx.DB) {
 	userRepository := userRepo.NewUserRepository(db)
 	authRepository := authRepo.NewAuthRepository(db)
 	sessionRepository := sessionRepo.NewSessionRepository(db)
-
-	middleware := middlewares.NewMiddleware(jwt, sessionRepository)
-
-	userService := userSvc.NewUserService(userRepository, validator, uuid, bcrypt)
+	eventRepository := eventRepo.NewEventRepository(db)
+	surveyRepository := surveyRepo.NewSurveyRepository(db)
+	questionRepository := questionRepo.NewSessionQuestionRepository(db)
+	pollRepository := pollRepo.NewSessionPollRepository(db)
+	notificationRepository := notificationRepo.NewNotificationRepository(db)
+	catalogueRepository := catalogueRepo.NewCatalogueRepository(db)
+
+	middleware := middlewares.NewMiddleware(jwt, userRepository, sessionRepository)
+
+	sessionService := sessionSvc.NewSessionService(
+		sessionRepository,
+		eventRepository,
+		userRepository,
+		notificationRepository,
+		pubsub,
+		spreadsheet,
+		validator,
+		uuid,
+	)
+	userService := userSvc.NewUserService(userRepository, sessionRepository, sessionService, validator, uuid, bcrypt)
 	authService := authSvc.NewAuthService(authRepository, validator, uuid, bcrypt, jwt)
-	sessionService := sessionSvc.NewSessionService(sessionRepository, validator, uuid)
+	eventService := eventSvc.NewEventService(eventRepository, validator, uuid)
+	surveyService := surveySvc.NewSurveyService(surveyRepository, sessionRepository, eventRepository, validator, uuid)
+	questionService := questionSvc.NewSessionQuestionService(
+		questionRepository,
+		sessionRepository,
+		pubsub,
+		validator,
+		uuid,
+	)
+	pollService := pollSvc.NewSessionPollService(pollRepository, sessionRepository, pubsub, validator, uuid)
+	notificationService := notificationSvc.NewNotificationService(notificationRepository, validator)
+	catalogueService := catalogueSvc.NewCatalogueService(catalogueRepository, userRepository, validator)
+	feedService := feedSvc.NewFeedService(sessionRepository, feed, validator)
 
 	userController.InitUserController(v1, userService, middleware)
 	authController.InitAuthController(v1, authService, middleware)
 	sessionController.InitSessionController(v1, sessionService, middleware)
+	eventController.InitEventController(v1, eventService, middleware)
+	surveyController.InitSurveyController(v1, surveyService, middleware)
+	questionController.InitSessionQuestionController(v1, questionService, middleware)
+	pollController.InitSessionPollController(v1, pollService, middleware)
+	notificationController.InitNotificationController(v1, notificationService, middleware)
+	catalogueController.InitCatalogueController(v1, catalogueService)
+	feedController.InitFeedController(v1, feedService)
 
 	s.app.Use(func(c *fiber.Ctx) error {
 		return c.SendFile("./web/not-found.html")
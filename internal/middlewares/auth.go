@@ -49,12 +49,59 @@ func (m *Middleware) RequireAuth() fiber.Handler {
 			return domain.ErrExpiredBearerToken
 		}
 
+		// tokens outlive the account, so deleted users are turned away here
+		_, err = m.userRepo.FindByID(ctx.Context(), claims.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domain.ErrUserDeleted
+			}
+
+			return err
+		}
+
 		ctx.Locals("claims", claims)
 
 		return ctx.Next()
 	}
 }
 
+// Like RequireAuth, but also takes the token from the query string, since
+// browsers can't set headers on websocket handshakes and EventSource requests.
+// Only mount it on stream routes.
+func (m *Middleware) RequireStreamAuth() fiber.Handler {
+	requireAuth := m.RequireAuth()
+
+	return func(ctx *fiber.Ctx) error {
+		token := takeQueryToken(ctx)
+		if ctx.Get("Authorization") == "" && token != "" {
+			ctx.Request().Header.Set("Authorization", "Bearer "+token)
+		}
+
+		return requireAuth(ctx)
+	}
+}
+
+// Removes the token from the request URI so it doesn't end up in the request log.
+func takeQueryToken(ctx *fiber.Ctx) string {
+	args := ctx.Request().URI().QueryArgs()
+
+	token := string(args.Peek("token"))
+	if token == "" {
+		return ""
+	}
+
+	args.Del("token")
+
+	uri, _, _ := strings.Cut(ctx.OriginalURL(), "?")
+	if args.Len() > 0 {
+		uri += "?" + string(args.QueryString())
+	}
+
+	ctx.Request().Header.SetRequestURI(uri)
+
+	return token
+}
+
 func (m *Middleware) AuthorizationSessionProposal() fiber.Handler {
 	return func(ctx *fiber.Ctx) error {
 		claims, ok := ctx.Locals("claims").(jwt.Claims)
@@ -0,0 +1,173 @@
+package middlewares
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/contracts"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain/entity"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/jwt"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// Knows only the users it was given, like the repository after the others were deleted.
+type fakeUserRepository struct {
+	contracts.UserRepository
+	userIDs []uuid.UUID
+}
+
+func (f *fakeUserRepository) FindByID(_ context.Context, id uuid.UUID) (*entity.User, error) {
+	for _, userID := range f.userIDs {
+		if userID == id {
+			return &entity.User{ID: id}, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func newTestApp(handler fiber.Handler) *fiber.App {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(ctx *fiber.Ctx, err error) error {
+			var reqErr *domain.RequestError
+			if errors.As(err, &reqErr) {
+				return ctx.SendStatus(reqErr.StatusCode)
+			}
+
+			return fiber.DefaultErrorHandler(ctx, err)
+		},
+	})
+
+	app.Get("/", handler, func(ctx *fiber.Ctx) error {
+		// the URL as the request logger would see it
+		return ctx.SendString(ctx.OriginalURL())
+	})
+
+	return app
+}
+
+func TestRequireAuth(t *testing.T) {
+	customJwt := &jwt.CustomJwtStruct{SecretKey: "secret", ExpiredTime: time.Hour}
+	userID := uuid.New()
+	token, err := customJwt.Create(userID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deletedToken, err := customJwt.Create(uuid.New(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	middleware := NewMiddleware(customJwt, &fakeUserRepository{userIDs: []uuid.UUID{userID}}, nil)
+
+	tests := []struct {
+		name       string
+		handler    fiber.Handler
+		url        string
+		header     string
+		wantStatus int
+		wantURL    string
+	}{
+		{
+			name:       "header token",
+			handler:    middleware.RequireAuth(),
+			url:        "/",
+			header:     "Bearer " + token,
+			wantStatus: fiber.StatusOK,
+			wantURL:    "/",
+		},
+		{
+			name:       "no token",
+			handler:    middleware.RequireAuth(),
+			url:        "/",
+			wantStatus: domain.ErrNoBearerToken.StatusCode,
+		},
+		{
+			name:       "invalid token",
+			handler:    middleware.RequireAuth(),
+			url:        "/",
+			header:     "Bearer invalid",
+			wantStatus: domain.ErrInvalidBearerToken.StatusCode,
+		},
+		{
+			name:       "deleted user",
+			handler:    middleware.RequireAuth(),
+			url:        "/",
+			header:     "Bearer " + deletedToken,
+			wantStatus: domain.ErrUserDeleted.StatusCode,
+		},
+		{
+			name:       "query token outside stream routes",
+			handler:    middleware.RequireAuth(),
+			url:        "/?token=" + token,
+			wantStatus: domain.ErrNoBearerToken.StatusCode,
+		},
+		{
+			name:       "query token on stream routes",
+			handler:    middleware.RequireStreamAuth(),
+			url:        "/?token=" + token,
+			wantStatus: fiber.StatusOK,
+			wantURL:    "/",
+		},
+		{
+			name:       "query token is stripped from the rest of the query",
+			handler:    middleware.RequireStreamAuth(),
+			url:        "/?event=1&token=" + token + "&after=2",
+			wantStatus: fiber.StatusOK,
+			wantURL:    "/?event=1&after=2",
+		},
+		{
+			name:       "header token on stream routes",
+			handler:    middleware.RequireStreamAuth(),
+			url:        "/?event=1",
+			header:     "Bearer " + token,
+			wantStatus: fiber.StatusOK,
+			wantURL:    "/?event=1",
+		},
+		{
+			name:       "invalid query token on stream routes",
+			handler:    middleware.RequireStreamAuth(),
+			url:        "/?token=invalid",
+			wantStatus: domain.ErrInvalidBearerToken.StatusCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, tt.url, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+
+			res, err := newTestApp(tt.handler).Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			if tt.wantURL == "" {
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(body) != tt.wantURL {
+				t.Errorf("url = %q, want %q", body, tt.wantURL)
+			}
+		})
+	}
+}
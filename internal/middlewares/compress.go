@@ -7,7 +7,9 @@ import (
 
 func Compress() fiber.Handler {
 	config := compress.Config{
-		Next:  nil,
+		Next: func(ctx *fiber.Ctx) bool {
+			return ctx.Get(fiber.HeaderAccept) == "text/event-stream"
+		},
 		Level: compress.LevelDefault,
 	}
 
@@ -7,9 +7,10 @@ import (
 
 func Cors() fiber.Handler {
 	config := cors.Config{
-		AllowMethods:  "GET,POST,PUT,DELETE,PATCH,OPTIONS,HEAD",
-		AllowHeaders:  "Content-Type,Authorization,X-API-Key,Accept,Origin,X-Requested-With,X-XSRF-Token,X-Cursor,Token-Type",
-		ExposeHeaders: "Content-Length",
+		AllowMethods: "GET,POST,PUT,DELETE,PATCH,OPTIONS,HEAD",
+		AllowHeaders: "Content-Type,Authorization,X-API-Key,Accept,Origin,X-Requested-With,X-XSRF-Token," +
+			"X-Cursor,Token-Type,If-None-Match", // If-None-Match revalidates cached public responses
+		ExposeHeaders: "Content-Length,ETag",
 	}
 
 	return cors.New(config)
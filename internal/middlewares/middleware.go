@@ -7,15 +7,18 @@ import (
 
 type Middleware struct {
 	jwt         jwt.CustomJwtInterface
+	userRepo    contracts.UserRepository
 	sessionRepo contracts.SessionRepository
 }
 
 func NewMiddleware(
 	jwt jwt.CustomJwtInterface,
+	userRepo contracts.UserRepository,
 	sessionRepo contracts.SessionRepository,
 ) *Middleware {
 	return &Middleware{
 		jwt:         jwt,
+		userRepo:    userRepo,
 		sessionRepo: sessionRepo,
 	}
 }
@@ -0,0 +1,51 @@
+package middlewares
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// Lets browsers and shared caches keep public responses for a while and
+// revalidate them with an ETag afterwards, answering 304 when nothing changed.
+func PublicCache() fiber.Handler {
+	maxAge := int(cmp.Or(env.AppEnv.PublicCacheMaxAge, 5*time.Minute).Seconds())
+	cacheControl := fmt.Sprintf("public, max-age=%d, stale-while-revalidate=%d", maxAge, maxAge)
+	tag := etag.New()
+
+	return func(ctx *fiber.Ctx) error {
+		err := tag(ctx)
+		if err != nil {
+			return err
+		}
+
+		status := ctx.Response().StatusCode()
+		if status == fiber.StatusOK || status == fiber.StatusNotModified {
+			ctx.Set(fiber.HeaderCacheControl, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+// Limits public requests per client IP, apart from the authenticated API.
+func PublicRateLimit() fiber.Handler {
+	config := limiter.Config{
+		Max:        cmp.Or(env.AppEnv.PublicRateLimit, 60),
+		Expiration: cmp.Or(env.AppEnv.PublicRateLimitWindow, time.Minute),
+		KeyGenerator: func(ctx *fiber.Ctx) string {
+			return "public:" + ctx.IP()
+		},
+		LimitReached: func(_ *fiber.Ctx) error {
+			return domain.ErrPublicRateLimited
+		},
+	}
+
+	return limiter.New(config)
+}
@@ -0,0 +1,132 @@
+package middlewares
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/domain"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestPublicCache(t *testing.T) {
+	env.AppEnv.PublicCacheMaxAge = time.Minute
+
+	app := fiber.New()
+	app.Get("/", PublicCache(), func(ctx *fiber.Ctx) error {
+		return ctx.SendString("sessions")
+	})
+	app.Get("/missing", PublicCache(), func(ctx *fiber.Ctx) error {
+		return ctx.Status(fiber.StatusNotFound).SendString("not found")
+	})
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	etag := res.Header.Get(fiber.HeaderETag)
+	if etag == "" {
+		t.Fatal("no ETag on the first response")
+	}
+
+	tests := []struct {
+		name             string
+		url              string
+		ifNoneMatch      string
+		wantStatus       int
+		wantCacheControl string
+	}{
+		{
+			name:             "fresh request",
+			url:              "/",
+			wantStatus:       fiber.StatusOK,
+			wantCacheControl: "public, max-age=60, stale-while-revalidate=60",
+		},
+		{
+			name:             "matching ETag",
+			url:              "/",
+			ifNoneMatch:      etag,
+			wantStatus:       fiber.StatusNotModified,
+			wantCacheControl: "public, max-age=60, stale-while-revalidate=60",
+		},
+		{
+			name:             "stale ETag",
+			url:              "/",
+			ifNoneMatch:      `"0-0"`,
+			wantStatus:       fiber.StatusOK,
+			wantCacheControl: "public, max-age=60, stale-while-revalidate=60",
+		},
+		{
+			name:       "error response",
+			url:        "/missing",
+			wantStatus: fiber.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, tt.url, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set(fiber.HeaderIfNoneMatch, tt.ifNoneMatch)
+			}
+
+			res, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			if got := res.Header.Get(fiber.HeaderCacheControl); got != tt.wantCacheControl {
+				t.Errorf("cache control = %q, want %q", got, tt.wantCacheControl)
+			}
+		})
+	}
+}
+
+func TestPublicRateLimit(t *testing.T) {
+	env.AppEnv.PublicRateLimit = 2
+	env.AppEnv.PublicRateLimitWindow = time.Minute
+
+	tests := []struct {
+		name         string
+		requests     int
+		wantStatuses []int
+	}{
+		{
+			name:         "within the limit",
+			requests:     2,
+			wantStatuses: []int{fiber.StatusOK, fiber.StatusOK},
+		},
+		{
+			name:     "over the limit",
+			requests: 3,
+			wantStatuses: []int{
+				fiber.StatusOK,
+				fiber.StatusOK,
+				domain.ErrPublicRateLimited.StatusCode,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(PublicRateLimit())
+
+			for i := range tt.requests {
+				res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if res.StatusCode != tt.wantStatuses[i] {
+					t.Errorf("request %d: status = %d, want %d", i+1, res.StatusCode, tt.wantStatuses[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at the last row of a page, or at the first one when walking
+// back to the previous page. Rows are ordered by the sort key, then by a
+// unique ID that breaks ties; UUIDv7 IDs keep that order close to insertion.
+type Cursor struct {
+	SortBy string `json:"s"`
+	Desc   bool   `json:"d,omitempty"`
+	Value  string `json:"v"`
+	ID     string `json:"i"`
+	Prev   bool   `json:"p,omitempty"`
+}
+
+// Encode turns the cursor into the opaque token handed to clients.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode reads a token made by Encode. It fails when the token was issued
+// for another sort, since its position means nothing in a different order.
+func Decode(token string, sortBy string, desc bool) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	err = json.Unmarshal(data, &c)
+	if err != nil || c.ID == "" || c.SortBy != sortBy || c.Desc != desc {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// Where selects the rows past the cursor in a list ordered by column then
+// idColumn, binding the cursor values after args. A nil cursor is the first
+// page and selects every row.
+func (c *Cursor) Where(column string, idColumn string, args []interface{}) (string, []interface{}) {
+	if c == nil {
+		return "", args
+	}
+
+	op := ">"
+	if c.Desc != c.Prev {
+		op = "<"
+	}
+
+	where := fmt.Sprintf(" AND (%s, %s) %s ($%d, $%d)", column, idColumn, op, len(args)+1, len(args)+2)
+
+	return where, append(args, c.Value, c.ID)
+}
+
+// Order is the direction to scan in. Walking back to the previous page scans
+// against the list order, Page puts the rows read back in list order.
+func (c *Cursor) Order(desc bool) string {
+	if c != nil && c.Prev {
+		desc = !desc
+	}
+
+	if desc {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+// Page trims the limit+1 rows read for a page to limit and returns the
+// cursors of the neighbouring pages, empty when there is none. key returns
+// the sort value and ID of a row.
+func Page[T any](
+	rows []T,
+	limit int,
+	c *Cursor,
+	sortBy string,
+	desc bool,
+	key func(row T) (string, string),
+) ([]T, string, string) {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	isPrev := c != nil && c.Prev
+	if isPrev {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	if len(rows) == 0 {
+		return rows, "", ""
+	}
+
+	// a page reached through a cursor always has a neighbour on the side it
+	// was reached from, the other side has one when more rows were read
+	hasNext, hasPrev := hasMore, c != nil
+	if isPrev {
+		hasNext, hasPrev = true, hasMore
+	}
+
+	var next, prev string
+
+	if hasNext {
+		value, id := key(rows[len(rows)-1])
+		next = Cursor{SortBy: sortBy, Desc: desc, Value: value, ID: id}.Encode()
+	}
+
+	if hasPrev {
+		value, id := key(rows[0])
+		prev = Cursor{SortBy: sortBy, Desc: desc, Value: value, ID: id, Prev: true}.Encode()
+	}
+
+	return rows, next, prev
+}
@@ -0,0 +1,233 @@
+package cursor
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	token := Cursor{SortBy: "title", Desc: true, Value: "Go", ID: "1"}.Encode()
+
+	tests := []struct {
+		name    string
+		token   string
+		sortBy  string
+		desc    bool
+		want    *Cursor
+		wantErr error
+	}{
+		{
+			name:   "round trip",
+			token:  token,
+			sortBy: "title",
+			desc:   true,
+			want:   &Cursor{SortBy: "title", Desc: true, Value: "Go", ID: "1"},
+		},
+		{
+			name:    "other sort",
+			token:   token,
+			sortBy:  "start_at",
+			desc:    true,
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "other direction",
+			token:   token,
+			sortBy:  "title",
+			desc:    false,
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "not base64",
+			token:   "%%%",
+			sortBy:  "title",
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "not json",
+			token:   "bm90IGpzb24",
+			sortBy:  "title",
+			wantErr: ErrInvalidCursor,
+		},
+		{
+			name:    "missing id",
+			token:   Cursor{SortBy: "title", Value: "Go"}.Encode(),
+			sortBy:  "title",
+			wantErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.token, tt.sortBy, tt.desc)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cursor = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhereAndOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		cursor    *Cursor
+		desc      bool
+		wantWhere string
+		wantArgs  []interface{}
+		wantOrder string
+	}{
+		{
+			name:      "first page",
+			wantArgs:  []interface{}{"event"},
+			wantOrder: "ASC",
+		},
+		{
+			name:      "first page descending",
+			desc:      true,
+			wantArgs:  []interface{}{"event"},
+			wantOrder: "DESC",
+		},
+		{
+			name:      "next page",
+			cursor:    &Cursor{Value: "b", ID: "2"},
+			wantWhere: " AND (title, id) > ($2, $3)",
+			wantArgs:  []interface{}{"event", "b", "2"},
+			wantOrder: "ASC",
+		},
+		{
+			name:      "next page descending",
+			cursor:    &Cursor{Desc: true, Value: "b", ID: "2"},
+			desc:      true,
+			wantWhere: " AND (title, id) < ($2, $3)",
+			wantArgs:  []interface{}{"event", "b", "2"},
+			wantOrder: "DESC",
+		},
+		{
+			name:      "previous page",
+			cursor:    &Cursor{Value: "b", ID: "2", Prev: true},
+			wantWhere: " AND (title, id) < ($2, $3)",
+			wantArgs:  []interface{}{"event", "b", "2"},
+			wantOrder: "DESC",
+		},
+		{
+			name:      "previous page descending",
+			cursor:    &Cursor{Desc: true, Value: "b", ID: "2", Prev: true},
+			desc:      true,
+			wantWhere: " AND (title, id) > ($2, $3)",
+			wantArgs:  []interface{}{"event", "b", "2"},
+			wantOrder: "ASC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, args := tt.cursor.Where("title", "id", []interface{}{"event"})
+			if where != tt.wantWhere {
+				t.Errorf("where = %q, want %q", where, tt.wantWhere)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+
+			if order := tt.cursor.Order(tt.desc); order != tt.wantOrder {
+				t.Errorf("order = %q, want %q", order, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	key := func(row string) (string, string) {
+		return row, row
+	}
+
+	tests := []struct {
+		name     string
+		rows     []string
+		cursor   *Cursor
+		wantRows []string
+		wantNext *Cursor
+		wantPrev *Cursor
+	}{
+		{
+			name:     "only page",
+			rows:     []string{"a", "b"},
+			wantRows: []string{"a", "b"},
+		},
+		{
+			name:     "first page with more",
+			rows:     []string{"a", "b", "c"},
+			wantRows: []string{"a", "b"},
+			wantNext: &Cursor{SortBy: "title", Value: "b", ID: "b"},
+		},
+		{
+			name:     "middle page",
+			rows:     []string{"c", "d", "e"},
+			cursor:   &Cursor{Value: "b", ID: "b"},
+			wantRows: []string{"c", "d"},
+			wantNext: &Cursor{SortBy: "title", Value: "d", ID: "d"},
+			wantPrev: &Cursor{SortBy: "title", Value: "c", ID: "c", Prev: true},
+		},
+		{
+			name:     "last page",
+			rows:     []string{"e"},
+			cursor:   &Cursor{Value: "d", ID: "d"},
+			wantRows: []string{"e"},
+			wantPrev: &Cursor{SortBy: "title", Value: "e", ID: "e", Prev: true},
+		},
+		{
+			name:     "back to a middle page",
+			rows:     []string{"d", "c", "b"},
+			cursor:   &Cursor{Value: "e", ID: "e", Prev: true},
+			wantRows: []string{"c", "d"},
+			wantNext: &Cursor{SortBy: "title", Value: "d", ID: "d"},
+			wantPrev: &Cursor{SortBy: "title", Value: "c", ID: "c", Prev: true},
+		},
+		{
+			name:     "back to the first page",
+			rows:     []string{"b", "a"},
+			cursor:   &Cursor{Value: "c", ID: "c", Prev: true},
+			wantRows: []string{"a", "b"},
+			wantNext: &Cursor{SortBy: "title", Value: "b", ID: "b"},
+		},
+		{
+			name:   "empty page",
+			cursor: &Cursor{Value: "z", ID: "z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, next, prev := Page(tt.rows, 2, tt.cursor, "title", false, key)
+
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", rows, tt.wantRows)
+			}
+
+			assertToken(t, "next", next, tt.wantNext)
+			assertToken(t, "prev", prev, tt.wantPrev)
+		})
+	}
+}
+
+func assertToken(t *testing.T, name string, token string, want *Cursor) {
+	t.Helper()
+
+	if want == nil {
+		if token != "" {
+			t.Errorf("%s = %q, want none", name, token)
+		}
+
+		return
+	}
+
+	if token != want.Encode() {
+		t.Errorf("%s = %q, want %q", name, token, want.Encode())
+	}
+}
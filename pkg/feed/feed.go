@@ -0,0 +1,270 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported feed format")
+
+// Content types of the formats Encode can produce, keyed by format.
+var ContentTypes = map[string]string{
+	"atom": "application/atom+xml; charset=utf-8",
+	"rss":  "application/rss+xml; charset=utf-8",
+	"json": "application/feed+json; charset=utf-8",
+}
+
+// Channel is a feed independent of its format. Link points at the page the
+// feed is about and SelfLink at the feed itself.
+type Channel struct {
+	ID          string
+	Title       string
+	Description string
+	Link        string
+	SelfLink    string
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item is one entry of a feed. An item whose Updated is after Published has
+// changed since it was first published.
+type Item struct {
+	ID         string
+	Title      string
+	Link       string
+	Summary    string
+	Author     string
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+}
+
+type CustomFeedInterface interface {
+	Encode(format string, channel Channel) ([]byte, error)
+}
+
+type CustomFeedStruct struct{}
+
+var Feed = getFeed()
+
+func getFeed() CustomFeedInterface {
+	return &CustomFeedStruct{}
+}
+
+func (f *CustomFeedStruct) Encode(format string, channel Channel) ([]byte, error) {
+	switch format {
+	case "atom":
+		return encodeXML(newAtomFeed(channel))
+	case "rss":
+		return encodeXML(newRSSFeed(channel))
+	case "json":
+		return json.Marshal(newJSONFeed(channel))
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+func encodeXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+// Atom, RFC 4287.
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func newAtomFeed(channel Channel) atomFeed {
+	feed := atomFeed{
+		ID:       channel.ID,
+		Title:    channel.Title,
+		Subtitle: channel.Description,
+		Updated:  channel.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: channel.SelfLink},
+			{Rel: "alternate", Href: channel.Link},
+		},
+	}
+
+	for _, item := range channel.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Link:      atomLink{Rel: "alternate", Href: item.Link},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+			Author:    atomAuthor{Name: item.Author},
+		}
+
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
+
+// RSS 2.0, with an Atom self link as feed validators recommend.
+type rssFeed struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	XMLNSAtom string     `xml:"xmlns:atom,attr"`
+	XMLNSDC   string     `xml:"xmlns:dc,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	SelfLink      atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func newRSSFeed(channel Channel) rssFeed {
+	feed := rssFeed{
+		Version:   "2.0",
+		XMLNSAtom: "http://www.w3.org/2005/Atom",
+		XMLNSDC:   "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         channel.Title,
+			Link:          channel.Link,
+			Description:   channel.Description,
+			LastBuildDate: channel.Updated.UTC().Format(time.RFC1123Z),
+			SelfLink:      atomLink{Rel: "self", Type: "application/rss+xml", Href: channel.SelfLink},
+		},
+	}
+
+	for _, item := range channel.Items {
+		// RSS has no update date, so a changed item gets a new guid and the
+		// date of the change for readers to pick it up again
+		guid := item.ID
+		if item.Updated.After(item.Published) {
+			guid += "#" + item.Updated.UTC().Format(time.RFC3339)
+		}
+
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Summary,
+			GUID:        rssGUID{Value: guid},
+			PubDate:     item.Updated.UTC().Format(time.RFC1123Z),
+			Creator:     item.Author,
+			Categories:  item.Categories,
+		})
+	}
+
+	return feed
+}
+
+// JSON Feed 1.1, https://www.jsonfeed.org/version/1.1/.
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+func newJSONFeed(channel Channel) jsonFeed {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       channel.Title,
+		HomePageURL: channel.Link,
+		FeedURL:     channel.SelfLink,
+		Description: channel.Description,
+		Items:       []jsonFeedItem{},
+	}
+
+	for _, item := range channel.Items {
+		feedItem := jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentText:   item.Summary,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+			Tags:          item.Categories,
+		}
+
+		if item.Author != "" {
+			feedItem.Authors = []jsonFeedAuthor{{Name: item.Author}}
+		}
+
+		feed.Items = append(feed.Items, feedItem)
+	}
+
+	return feed
+}
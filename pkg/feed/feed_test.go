@@ -0,0 +1,119 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncode(t *testing.T) {
+	published := time.Date(2025, 5, 1, 10, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	updated := published.Add(time.Hour)
+
+	channel := Channel{
+		ID:          "urn:uuid:events",
+		Title:       "Sessions",
+		Description: "Upcoming sessions",
+		Link:        "https://example.com/sessions",
+		SelfLink:    "https://example.com/sessions/feed",
+		Updated:     updated,
+		Items: []Item{
+			{
+				ID:         "urn:uuid:go",
+				Title:      "Go",
+				Link:       "https://example.com/sessions/go",
+				Summary:    "Concurrency",
+				Author:     "Gopher",
+				Categories: []string{"backend"},
+				Published:  published,
+				Updated:    published,
+			},
+			{
+				ID:        "urn:uuid:rust",
+				Title:     "Rust",
+				Link:      "https://example.com/sessions/rust",
+				Published: published,
+				Updated:   updated,
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		format       string
+		wantContains []string
+		wantErr      error
+	}{
+		{
+			name:   "atom",
+			format: "atom",
+			wantContains: []string{
+				`<feed xmlns="http://www.w3.org/2005/Atom">`,
+				`<link rel="self" type="application/atom+xml" href="https://example.com/sessions/feed"></link>`,
+				`<published>2025-05-01T03:00:00Z</published>`,
+				`<updated>2025-05-01T04:00:00Z</updated>`,
+				`<category term="backend"></category>`,
+			},
+		},
+		{
+			name:   "rss",
+			format: "rss",
+			wantContains: []string{
+				`<rss version="2.0"`,
+				`<lastBuildDate>Thu, 01 May 2025 04:00:00 +0000</lastBuildDate>`,
+				`<guid isPermaLink="false">urn:uuid:go</guid>`,
+				`<guid isPermaLink="false">urn:uuid:rust#2025-05-01T04:00:00Z</guid>`,
+				`<dc:creator>Gopher</dc:creator>`,
+			},
+		},
+		{
+			name:   "json",
+			format: "json",
+			wantContains: []string{
+				`"version":"https://jsonfeed.org/version/1.1"`,
+				`"feed_url":"https://example.com/sessions/feed"`,
+				`"date_modified":"2025-05-01T04:00:00Z"`,
+				`"tags":["backend"]`,
+			},
+		},
+		{
+			name:    "unsupported format",
+			format:  "csv",
+			wantErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := Feed.Encode(tt.format, channel)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if _, ok := ContentTypes[tt.format]; !ok {
+				t.Errorf("no content type for %q", tt.format)
+			}
+
+			if tt.format == "json" {
+				if !json.Valid(body) {
+					t.Errorf("invalid json: %s", body)
+				}
+			} else if err := xml.Unmarshal(body, new(struct{})); err != nil {
+				t.Errorf("invalid xml: %v", err)
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("body does not contain %s\n%s", want, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Hands a subscription over to the websocket handler mounted after the current one.
+// The handler only runs once the connection is upgraded, otherwise the
+// subscription is dropped here.
+func Subscribe(ctx *fiber.Ctx, messages <-chan []byte, unsubscribe func()) error {
+	ctx.Locals("messages", messages)
+	ctx.Locals("unsubscribe", unsubscribe)
+
+	err := ctx.Next()
+	if ctx.Response().StatusCode() != fiber.StatusSwitchingProtocols {
+		unsubscribe()
+	}
+
+	return err
+}
+
+func WebSocket() fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		messages, _ := conn.Locals("messages").(<-chan []byte)
+		unsubscribe, _ := conn.Locals("unsubscribe").(func())
+		defer unsubscribe()
+
+		// the client only listens, reading is needed to notice when it goes away
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+					return
+				}
+			case <-closed:
+				return
+			}
+		}
+	})
+}
+
+// Streams a subscription to the client as server-sent events until it disconnects.
+func EventStream(ctx *fiber.Ctx, event string, messages <-chan []byte, unsubscribe func()) error {
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		heartbeat := time.NewTicker(15 * time.Second)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, message)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// Streams a file download. write runs after the handler has returned, so it
+// must not touch ctx. The context it gets ends when the server shuts down or
+// the client stops reading.
+func File(
+	ctx *fiber.Ctx,
+	filename string,
+	contentType string,
+	write func(ctx context.Context, w io.Writer) error,
+) error {
+	ctx.Set(fiber.HeaderContentType, contentType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"%s\"", filename))
+
+	requestCtx := ctx.Context()
+	requestCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		writeCtx, cancel := context.WithCancel(requestCtx)
+		defer cancel()
+
+		err := write(writeCtx, &cancelOnErrorWriter{w: w, cancel: cancel})
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error":    err.Error(),
+				"filename": filename,
+			}, "[STREAM][File] failed to write file")
+		}
+
+		_ = w.Flush()
+	})
+
+	return nil
+}
+
+// Cancels the write context once the connection fails, so whatever produces
+// the rows stops too.
+type cancelOnErrorWriter struct {
+	w      io.Writer
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnErrorWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	if err != nil {
+		c.cancel()
+	}
+
+	return n, err
+}
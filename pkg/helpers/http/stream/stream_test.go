@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestSubscribeUnsubscribesWithoutUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{
+			name: "plain request",
+		},
+		{
+			name: "unsupported websocket version",
+			headers: map[string]string{
+				"Connection":            "Upgrade",
+				"Upgrade":               "websocket",
+				"Sec-WebSocket-Version": "8",
+				"Sec-WebSocket-Key":     "dGhlIHNhbXBsZSBub25jZQ==",
+			},
+		},
+		{
+			name: "missing websocket key",
+			headers: map[string]string{
+				"Connection":            "Upgrade",
+				"Upgrade":               "websocket",
+				"Sec-WebSocket-Version": "13",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			countUnsubscribes := 0
+
+			app := fiber.New()
+			app.Get("/", func(ctx *fiber.Ctx) error {
+				return Subscribe(ctx, make(chan []byte), func() { countUnsubscribes++ })
+			}, WebSocket())
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			res, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.StatusCode == fiber.StatusSwitchingProtocols {
+				t.Fatalf("status = %d, want the upgrade to fail", res.StatusCode)
+			}
+
+			if countUnsubscribes != 1 {
+				t.Errorf("unsubscribed %d times, want 1", countUnsubscribes)
+			}
+		})
+	}
+}
+
+func TestFile(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		return File(ctx, "sessions.csv", "text/csv", func(ctx context.Context, w io.Writer) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			_, err := io.WriteString(w, "id,title\n")
+
+			return err
+		})
+	})
+
+	res, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != "id,title\n" {
+		t.Errorf("body = %q, want %q", body, "id,title\n")
+	}
+
+	disposition := res.Header.Get(fiber.HeaderContentDisposition)
+	if disposition != `attachment; filename="sessions.csv"` {
+		t.Errorf("content disposition = %q", disposition)
+	}
+}
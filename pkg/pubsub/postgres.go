@@ -0,0 +1,115 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/jackc/pgx/v5"
+	// pgx driver for postgres
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const postgresChannel = "pubsub"
+
+type PostgresPubSubStruct struct {
+	local *CustomPubSubStruct
+	db    *sql.DB
+	dsn   string
+}
+
+type postgresMessage struct {
+	Topic   string `json:"topic"`
+	Payload []byte `json:"payload"`
+}
+
+// Messages go through Postgres LISTEN/NOTIFY so subscribers on every app instance receive them.
+func newPostgresPubSub(dsn string) CustomPubSubInterface {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Panic(log.LogInfo{
+			"error": err.Error(),
+		}, "[PubSub][newPostgresPubSub] failed to open database")
+	}
+
+	p := &PostgresPubSubStruct{
+		local: newMemoryPubSub(),
+		db:    db,
+		dsn:   dsn,
+	}
+
+	go p.listen()
+
+	return p
+}
+
+func (p *PostgresPubSubStruct) Publish(topic string, payload []byte) {
+	message, err := json.Marshal(postgresMessage{
+		Topic:   topic,
+		Payload: payload,
+	})
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err.Error(),
+		}, "[PubSub][Publish] failed to marshal message")
+
+		return
+	}
+
+	_, err = p.db.Exec("SELECT pg_notify($1, $2)", postgresChannel, string(message))
+	if err != nil {
+		log.Error(log.LogInfo{
+			"error": err.Error(),
+		}, "[PubSub][Publish] failed to notify")
+	}
+}
+
+func (p *PostgresPubSubStruct) Subscribe(topic string) (<-chan []byte, func()) {
+	return p.local.Subscribe(topic)
+}
+
+func (p *PostgresPubSubStruct) listen() {
+	for {
+		err := p.receive(context.Background())
+
+		log.Error(log.LogInfo{
+			"error": err.Error(),
+		}, "[PubSub][listen] lost listener connection, reconnecting")
+
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func (p *PostgresPubSubStruct) receive(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, p.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "LISTEN "+postgresChannel)
+	if err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var message postgresMessage
+		err = json.Unmarshal([]byte(notification.Payload), &message)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err.Error(),
+			}, "[PubSub][receive] failed to unmarshal message")
+
+			continue
+		}
+
+		p.local.Publish(message.Topic, message.Payload)
+	}
+}
@@ -0,0 +1,133 @@
+package pubsub
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+)
+
+type CustomPubSubInterface interface {
+	Publish(topic string, payload []byte)
+	Subscribe(topic string) (<-chan []byte, func())
+}
+
+type CustomPubSubStruct struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+var PubSub = getPubSub()
+
+func getPubSub() CustomPubSubInterface {
+	if env.AppEnv.PubSubDriver == "postgres" {
+		return newPostgresPubSub(fmt.Sprintf(
+			"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+			env.AppEnv.DBHost,
+			env.AppEnv.DBPort,
+			env.AppEnv.DBUser,
+			env.AppEnv.DBPass,
+			env.AppEnv.DBName,
+		))
+	}
+
+	return newMemoryPubSub()
+}
+
+func newMemoryPubSub() *CustomPubSubStruct {
+	return &CustomPubSubStruct{
+		subscribers: map[string]map[chan []byte]struct{}{},
+	}
+}
+
+// Slow subscribers miss messages instead of blocking the publisher.
+func (p *CustomPubSubStruct) Publish(topic string, payload []byte) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for subscriber := range p.subscribers[topic] {
+		select {
+		case subscriber <- payload:
+		default:
+		}
+	}
+}
+
+func (p *CustomPubSubStruct) Subscribe(topic string) (<-chan []byte, func()) {
+	subscriber := make(chan []byte, 16)
+
+	p.mu.Lock()
+	if p.subscribers[topic] == nil {
+		p.subscribers[topic] = map[chan []byte]struct{}{}
+	}
+	p.subscribers[topic][subscriber] = struct{}{}
+	p.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			p.mu.Lock()
+			delete(p.subscribers[topic], subscriber)
+			if len(p.subscribers[topic]) == 0 {
+				delete(p.subscribers, topic)
+			}
+			p.mu.Unlock()
+
+			close(subscriber)
+		})
+	}
+
+	return subscriber, unsubscribe
+}
+
+// Merges the subscriptions of several topics into a single channel.
+func SubscribeAll(p CustomPubSubInterface, topics []string) (<-chan []byte, func()) {
+	merged := make(chan []byte, 16)
+	done := make(chan struct{})
+	unsubscribes := []func(){}
+
+	var wg sync.WaitGroup
+	for _, topic := range topics {
+		messages, unsubscribe := p.Subscribe(topic)
+		unsubscribes = append(unsubscribes, unsubscribe)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case message, ok := <-messages:
+					if !ok {
+						return
+					}
+
+					select {
+					case merged <- message:
+					default:
+					}
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			close(done)
+
+			for _, unsubscribe := range unsubscribes {
+				unsubscribe()
+			}
+		})
+	}
+
+	return merged, unsubscribe
+}
@@ -0,0 +1,259 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+	"github.com/xuri/excelize/v2"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported spreadsheet format")
+
+// Content types of the formats NewWriter can produce, keyed by format.
+var ContentTypes = map[string]string{
+	"csv":    "text/csv",
+	"xlsx":   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"ndjson": "application/x-ndjson",
+}
+
+type CustomSpreadsheetInterface interface {
+	Read(filename string, r io.Reader) ([][]string, error)
+	NewWriter(format string, w io.Writer, header []string) (Writer, error)
+}
+
+// Writer writes records one at a time. Close must be called to flush the
+// remaining output.
+type Writer interface {
+	Write(record []string) error
+	Close() error
+}
+
+type CustomSpreadsheetStruct struct{}
+
+var Spreadsheet = getSpreadsheet()
+
+func getSpreadsheet() CustomSpreadsheetInterface {
+	return &CustomSpreadsheetStruct{}
+}
+
+// Reads every row of a CSV file or of the first sheet of an XLSX file. The
+// format is picked from the file extension.
+func (s *CustomSpreadsheetStruct) Read(filename string, r io.Reader) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		rows, err := reader.ReadAll()
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err.Error(),
+			}, "[SPREADSHEET][Read] failed to read csv")
+
+			return nil, err
+		}
+
+		return rows, nil
+	case ".xlsx":
+		file, err := excelize.OpenReader(r)
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err.Error(),
+			}, "[SPREADSHEET][Read] failed to open xlsx")
+
+			return nil, err
+		}
+		defer file.Close()
+
+		// raw values keep dates as serial numbers instead of locale formatted text
+		rows, err := file.GetRows(file.GetSheetName(0), excelize.Options{RawCellValue: true})
+		if err != nil {
+			log.Error(log.LogInfo{
+				"error": err.Error(),
+			}, "[SPREADSHEET][Read] failed to read xlsx")
+
+			return nil, err
+		}
+
+		return rows, nil
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+func (s *CustomSpreadsheetStruct) NewWriter(format string, w io.Writer, header []string) (Writer, error) {
+	var writer Writer
+
+	switch format {
+	case "csv":
+		writer = &csvWriter{writer: csv.NewWriter(w)}
+	case "xlsx":
+		file := excelize.NewFile()
+
+		stream, err := file.NewStreamWriter(file.GetSheetName(0))
+		if err != nil {
+			_ = file.Close()
+
+			return nil, err
+		}
+
+		writer = &xlsxWriter{file: file, stream: stream, w: w}
+	case "ndjson":
+		writer = &ndjsonWriter{w: w, header: header}
+
+		return writer, nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	err := writer.Write(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+	rows   int
+}
+
+func (c *csvWriter) Write(record []string) error {
+	err := c.writer.Write(record)
+	if err != nil {
+		return err
+	}
+
+	c.rows++
+	if c.rows%100 == 0 {
+		c.writer.Flush()
+
+		return c.writer.Error()
+	}
+
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+// Rows go through excelize's stream writer, which spills to a temporary file
+// instead of keeping the whole sheet in memory. The workbook is written out on
+// Close.
+type xlsxWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	w      io.Writer
+	rows   int
+}
+
+func (x *xlsxWriter) Write(record []string) error {
+	values := make([]interface{}, len(record))
+	for i, value := range record {
+		values[i] = value
+	}
+
+	x.rows++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	err := x.stream.Flush()
+	if err != nil {
+		return err
+	}
+
+	return x.file.Write(x.w)
+}
+
+// Writes one JSON object per line with the keys in header order.
+type ndjsonWriter struct {
+	w      io.Writer
+	header []string
+}
+
+func (n *ndjsonWriter) Write(record []string) error {
+	var line bytes.Buffer
+	line.WriteByte('{')
+
+	for i, key := range n.header {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+
+		value := ""
+		if i < len(record) {
+			value = record[i]
+		}
+
+		encodedKey, _ := json.Marshal(key)
+		encodedValue, _ := json.Marshal(value)
+
+		line.Write(encodedKey)
+		line.WriteByte(':')
+		line.Write(encodedValue)
+	}
+
+	line.WriteString("}\n")
+
+	_, err := n.w.Write(line.Bytes())
+
+	return err
+}
+
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
+
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// Parses a date time cell written as RFC 3339, or as "2006-01-02 15:04[:05]"
+// or an Excel serial number read in loc.
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, value, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	serial, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := excelize.ExcelDateToTime(serial, false)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// serial numbers carry no zone, the wall clock is read in loc
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc), nil
+}
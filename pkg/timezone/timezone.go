@@ -0,0 +1,129 @@
+package timezone
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	_ "time/tzdata" // zone names resolve even on images without a zoneinfo database
+
+	"github.com/ahargunyllib/freepass-be-bcc-2025/internal/infra/env"
+	"github.com/ahargunyllib/freepass-be-bcc-2025/pkg/log"
+)
+
+// Layout of a wall clock time, without offset.
+const WallLayout = "2006-01-02T15:04:05"
+
+var ErrInvalidTime = errors.New("invalid time")
+
+// Zone of events and sessions that do not name one.
+var Default = getDefault()
+
+func getDefault() *time.Location {
+	name := env.AppEnv.DefaultTimeZone
+	if name == "" {
+		name = "Asia/Jakarta"
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Fatal(log.LogInfo{
+			"error": err.Error(),
+		}, "[TIMEZONE][getDefault] failed to load default time zone")
+	}
+
+	return loc
+}
+
+// Location loads the named zone, falling back to Default when the name is
+// empty or unknown.
+func Location(name string) *time.Location {
+	if name == "" {
+		return Default
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return Default
+	}
+
+	return loc
+}
+
+// WallTime formats t as the wall clock time of the named zone.
+func WallTime(t time.Time, name string) string {
+	return t.In(Location(name)).Format(WallLayout)
+}
+
+// A query string decodes "+" as a space, which breaks offsets such as
+// "2025-05-01T10:00:00+07:00" unless the client escaped them.
+var spacedOffset = regexp.MustCompile(`T\d{2}:\d{2}(:\d{2}(\.\d+)?)? (\d{2}:?\d{2})$`)
+
+// Matches a wall time qualified with a zone name, "2025-05-01T10:00:00[Asia/Jakarta]".
+var namedZone = regexp.MustCompile(`^(.+?)(Z|[+-]\d{2}:\d{2})?\[([^\]]+)\]$`)
+
+var wallLayouts = []string{
+	WallLayout,
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Parse reads an RFC 3339 time, a wall time followed by a zone name in
+// brackets, or a bare wall time read in the Default zone.
+func Parse(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if spacedOffset.MatchString(value) {
+		i := strings.LastIndex(value, " ")
+		value = value[:i] + "+" + value[i+1:]
+	}
+
+	if match := namedZone.FindStringSubmatch(value); match != nil {
+		loc, err := time.LoadLocation(match[3])
+		if err != nil {
+			return time.Time{}, ErrInvalidTime
+		}
+
+		// an explicit offset pins the instant, the zone name only relabels it
+		if match[2] != "" {
+			t, err := time.Parse(time.RFC3339Nano, match[1]+match[2])
+			if err != nil {
+				return time.Time{}, ErrInvalidTime
+			}
+
+			return t.In(loc), nil
+		}
+
+		return parseWall(match[1], loc)
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err == nil {
+		return t, nil
+	}
+
+	return parseWall(value, Default)
+}
+
+func parseWall(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range wallLayouts {
+		t, err := time.ParseInLocation(layout, value, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrInvalidTime
+}
+
+// QueryConverter decodes time.Time query parameters with Parse. An invalid
+// value yields an invalid reflect.Value, which the parser reports as an error.
+func QueryConverter(value string) reflect.Value {
+	t, err := Parse(value)
+	if err != nil {
+		return reflect.Value{}
+	}
+
+	return reflect.ValueOf(t)
+}
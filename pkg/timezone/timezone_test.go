@@ -0,0 +1,152 @@
+package timezone
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocation(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		want string
+	}{
+		{name: "named zone", zone: "Europe/Amsterdam", want: "Europe/Amsterdam"},
+		{name: "empty", zone: "", want: Default.String()},
+		{name: "unknown", zone: "Mars/Olympus_Mons", want: Default.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Location(tt.zone).String(); got != tt.want {
+				t.Errorf("location = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWallTime(t *testing.T) {
+	instant := time.Date(2025, 5, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		zone string
+		want string
+	}{
+		{name: "ahead of UTC", zone: "Asia/Jakarta", want: "2025-05-01T10:00:00"},
+		{name: "behind UTC", zone: "America/New_York", want: "2025-04-30T23:00:00"},
+		{name: "UTC", zone: "UTC", want: "2025-05-01T03:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WallTime(instant, tt.zone); got != tt.want {
+				t.Errorf("wall time = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	jakarta := Location("Asia/Jakarta")
+	amsterdam := Location("Europe/Amsterdam")
+
+	tests := []struct {
+		name     string
+		value    string
+		want     time.Time
+		wantZone string
+		wantErr  error
+	}{
+		{
+			name:  "RFC 3339",
+			value: "2025-05-01T10:00:00+07:00",
+			want:  time.Date(2025, 5, 1, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "RFC 3339 in UTC",
+			value: "2025-05-01T03:00:00Z",
+			want:  time.Date(2025, 5, 1, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "offset with its plus decoded as a space",
+			value: "2025-05-01T10:00:00 07:00",
+			want:  time.Date(2025, 5, 1, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "wall time with a zone name",
+			value:    "2025-05-01T10:00:00[Europe/Amsterdam]",
+			want:     time.Date(2025, 5, 1, 10, 0, 0, 0, amsterdam),
+			wantZone: "Europe/Amsterdam",
+		},
+		{
+			name:     "offset with a zone name keeps the instant",
+			value:    "2025-05-01T10:00:00+07:00[Europe/Amsterdam]",
+			want:     time.Date(2025, 5, 1, 3, 0, 0, 0, time.UTC),
+			wantZone: "Europe/Amsterdam",
+		},
+		{
+			name:     "bare wall time",
+			value:    "2025-05-01T10:00:00",
+			want:     time.Date(2025, 5, 1, 10, 0, 0, 0, jakarta),
+			wantZone: "Asia/Jakarta",
+		},
+		{
+			name:  "wall time without seconds",
+			value: " 2025-05-01T10:00 ",
+			want:  time.Date(2025, 5, 1, 10, 0, 0, 0, jakarta),
+		},
+		{
+			name:  "date",
+			value: "2025-05-01",
+			want:  time.Date(2025, 5, 1, 0, 0, 0, 0, jakarta),
+		},
+		{
+			name:    "unknown zone name",
+			value:   "2025-05-01T10:00:00[Mars/Olympus_Mons]",
+			wantErr: ErrInvalidTime,
+		},
+		{
+			name:    "not a time",
+			value:   "tomorrow",
+			wantErr: ErrInvalidTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("time = %s, want %s", got, tt.want)
+			}
+
+			if tt.wantZone != "" && got.Location().String() != tt.wantZone {
+				t.Errorf("zone = %q, want %q", got.Location(), tt.wantZone)
+			}
+		})
+	}
+}
+
+func TestQueryConverter(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantValid bool
+	}{
+		{name: "valid", value: "2025-05-01T10:00:00+07:00", wantValid: true},
+		{name: "invalid", value: "tomorrow", wantValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QueryConverter(tt.value).IsValid(); got != tt.wantValid {
+				t.Errorf("valid = %v, want %v", got, tt.wantValid)
+			}
+		})
+	}
+}
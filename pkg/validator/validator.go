@@ -78,40 +78,32 @@ func (v *ValidatorStruct) Validate(data interface{}) ValidationErrors {
 				tag, fieldName := getTagAndFieldName(field)
 
 				if tag == "json" {
-					body.Fields = map[string]FieldError{
-						fieldName: {
-							Tag:     err.Tag(),
-							Message: err.Translate(v.trans),
-						},
+					body.Fields[fieldName] = FieldError{
+						Tag:     err.Tag(),
+						Message: err.Translate(v.trans),
 					}
 					continue
 				}
 
 				if tag == "param" {
-					param.Fields = map[string]FieldError{
-						fieldName: {
-							Tag:     err.Tag(),
-							Message: err.Translate(v.trans),
-						},
+					param.Fields[fieldName] = FieldError{
+						Tag:     err.Tag(),
+						Message: err.Translate(v.trans),
 					}
 					continue
 				}
 
 				if tag == "query" {
-					query.Fields = map[string]FieldError{
-						fieldName: {
-							Tag:     err.Tag(),
-							Message: err.Translate(v.trans),
-						},
+					query.Fields[fieldName] = FieldError{
+						Tag:     err.Tag(),
+						Message: err.Translate(v.trans),
 					}
 					continue
 				}
 
-				other.Fields = map[string]FieldError{
-					fieldName: {
-						Tag:     err.Tag(),
-						Message: err.Translate(v.trans),
-					},
+				other.Fields[fieldName] = FieldError{
+					Tag:     err.Tag(),
+					Message: err.Translate(v.trans),
 				}
 			}
 